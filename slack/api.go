@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/slack-go/slack"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiClient calls Slack Web API methods and parameters that github.com/slack-go/slack doesn't support yet.
+type apiClient struct {
+	token      string
+	endpoint   string
+	httpClient *http.Client
+}
+
+type apiResponse interface {
+	Err() error
+}
+
+func newApiClient(token string, endpoint string, httpClient *http.Client) *apiClient {
+	return &apiClient{
+		token:      token,
+		endpoint:   endpoint,
+		httpClient: httpClient,
+	}
+}
+
+func (c *apiClient) postMethod(ctx context.Context, method string, values url.Values, response apiResponse) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+method, strings.NewReader(values.Encode()))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	// Follow the error types of github.com/slack-go/slack so that callers can handle errors in the same way
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retry, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		return &slack.RateLimitedError{RetryAfter: time.Duration(retry) * time.Second}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return slack.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+
+	return response.Err()
+}
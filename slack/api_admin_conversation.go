@@ -0,0 +1,243 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/slack-go/slack"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type conversationPrefEntities struct {
+	Types      []string `json:"type"`
+	Users      []string `json:"user"`
+	UserGroups []string `json:"subteam"`
+}
+
+type conversationPrefs struct {
+	WhoCanPost conversationPrefEntities `json:"who_can_post"`
+	CanThread  conversationPrefEntities `json:"can_thread"`
+}
+
+type conversationPrefsResponse struct {
+	slack.SlackResponse
+	Prefs conversationPrefs `json:"prefs"`
+}
+
+// format returns a value like type:admin,user:U1234,subteam:S1234
+func (e conversationPrefEntities) format() string {
+	var values []string
+
+	for _, v := range e.Types {
+		values = append(values, "type:"+v)
+	}
+
+	for _, v := range e.Users {
+		values = append(values, "user:"+v)
+	}
+
+	for _, v := range e.UserGroups {
+		values = append(values, "subteam:"+v)
+	}
+
+	return strings.Join(values, ",")
+}
+
+func (p conversationPrefs) isEmpty() bool {
+	return p.WhoCanPost.format() == "" && p.CanThread.format() == ""
+}
+
+// https://api.slack.com/methods/admin.conversations.getConversationPrefs
+func (c *apiClient) getConversationPrefs(ctx context.Context, channelId string) (*conversationPrefs, error) {
+	values := url.Values{
+		"channel_id": {channelId},
+	}
+
+	response := &conversationPrefsResponse{}
+
+	if err := c.postMethod(ctx, "admin.conversations.getConversationPrefs", values, response); err != nil {
+		return nil, err
+	}
+
+	return &response.Prefs, nil
+}
+
+// https://api.slack.com/methods/admin.conversations.setConversationPrefs
+func (c *apiClient) setConversationPrefs(ctx context.Context, channelId string, prefs conversationPrefs) error {
+	encoded, err := json.Marshal(map[string]string{
+		"who_can_post": prefs.WhoCanPost.format(),
+		"can_thread":   prefs.CanThread.format(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	values := url.Values{
+		"channel_id": {channelId},
+		"prefs":      {string(encoded)},
+	}
+
+	return c.postMethod(ctx, "admin.conversations.setConversationPrefs", values, &slack.SlackResponse{})
+}
+
+type customRetentionResponse struct {
+	slack.SlackResponse
+	IsPolicyEnabled bool `json:"is_policy_enabled"`
+	DurationDays    int  `json:"duration_days"`
+}
+
+// https://api.slack.com/methods/admin.conversations.getCustomRetention
+func (c *apiClient) getCustomRetention(ctx context.Context, channelId string) (*customRetentionResponse, error) {
+	values := url.Values{
+		"channel_id": {channelId},
+	}
+
+	response := &customRetentionResponse{}
+
+	if err := c.postMethod(ctx, "admin.conversations.getCustomRetention", values, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// https://api.slack.com/methods/admin.conversations.setCustomRetention
+func (c *apiClient) setCustomRetention(ctx context.Context, channelId string, durationDays int) error {
+	values := url.Values{
+		"channel_id":    {channelId},
+		"duration_days": {strconv.Itoa(durationDays)},
+	}
+
+	return c.postMethod(ctx, "admin.conversations.setCustomRetention", values, &slack.SlackResponse{})
+}
+
+// https://api.slack.com/methods/admin.conversations.removeCustomRetention
+func (c *apiClient) removeCustomRetention(ctx context.Context, channelId string) error {
+	values := url.Values{
+		"channel_id": {channelId},
+	}
+
+	return c.postMethod(ctx, "admin.conversations.removeCustomRetention", values, &slack.SlackResponse{})
+}
+
+type adminConversationCreateResponse struct {
+	slack.SlackResponse
+	ChannelID string `json:"channel_id"`
+}
+
+// https://api.slack.com/methods/admin.conversations.create
+func (c *apiClient) createConversation(ctx context.Context, name string, isPrivate bool, orgWide bool, teamId string) (string, error) {
+	values := url.Values{
+		"name":       {name},
+		"is_private": {strconv.FormatBool(isPrivate)},
+		"org_wide":   {strconv.FormatBool(orgWide)},
+	}
+
+	if teamId != "" {
+		values.Add("team_id", teamId)
+	}
+
+	response := &adminConversationCreateResponse{}
+
+	if err := c.postMethod(ctx, "admin.conversations.create", values, response); err != nil {
+		return "", err
+	}
+
+	return response.ChannelID, nil
+}
+
+type adminConversationTeamsResponse struct {
+	slack.SlackResponse
+	TeamIDs []string `json:"team_ids"`
+}
+
+// getConversationTeams returns all workspaces through all pages of https://api.slack.com/methods/admin.conversations.getTeams
+func (c *apiClient) getConversationTeams(ctx context.Context, channelId string) ([]string, error) {
+	values := url.Values{
+		"channel_id": {channelId},
+		"limit":      {"1000"},
+	}
+
+	var teamIds []string
+
+	for {
+		response := &adminConversationTeamsResponse{}
+
+		if err := c.postMethod(ctx, "admin.conversations.getTeams", values, response); err != nil {
+			return nil, err
+		}
+
+		teamIds = append(teamIds, response.TeamIDs...)
+
+		if response.ResponseMetadata.Cursor == "" {
+			return teamIds, nil
+		}
+
+		values.Set("cursor", response.ResponseMetadata.Cursor)
+	}
+}
+
+// https://api.slack.com/methods/admin.conversations.setTeams
+func (c *apiClient) setConversationTeams(ctx context.Context, channelId string, teamIds []string, orgChannel bool) error {
+	values := url.Values{
+		"channel_id":      {channelId},
+		"target_team_ids": {strings.Join(teamIds, ",")},
+		"org_channel":     {strconv.FormatBool(orgChannel)},
+	}
+
+	return c.postMethod(ctx, "admin.conversations.setTeams", values, &slack.SlackResponse{})
+}
+
+type restrictAccessGroupsResponse struct {
+	slack.SlackResponse
+	GroupIDs []string `json:"group_ids"`
+}
+
+// https://api.slack.com/methods/admin.conversations.restrictAccess.listGroups
+func (c *apiClient) listRestrictAccessGroups(ctx context.Context, channelId string, teamId string) ([]string, error) {
+	values := url.Values{
+		"channel_id": {channelId},
+	}
+
+	if teamId != "" {
+		values.Add("team_id", teamId)
+	}
+
+	response := &restrictAccessGroupsResponse{}
+
+	if err := c.postMethod(ctx, "admin.conversations.restrictAccess.listGroups", values, response); err != nil {
+		return nil, err
+	}
+
+	return response.GroupIDs, nil
+}
+
+// https://api.slack.com/methods/admin.conversations.restrictAccess.addGroup
+func (c *apiClient) addRestrictAccessGroup(ctx context.Context, channelId string, groupId string, teamId string) error {
+	values := url.Values{
+		"channel_id": {channelId},
+		"group_id":   {groupId},
+	}
+
+	if teamId != "" {
+		values.Add("team_id", teamId)
+	}
+
+	return c.postMethod(ctx, "admin.conversations.restrictAccess.addGroup", values, &slack.SlackResponse{})
+}
+
+// https://api.slack.com/methods/admin.conversations.restrictAccess.removeGroup
+func (c *apiClient) removeRestrictAccessGroup(ctx context.Context, channelId string, groupId string, teamId string) error {
+	values := url.Values{
+		"channel_id": {channelId},
+		"group_id":   {groupId},
+	}
+
+	if teamId != "" {
+		values.Add("team_id", teamId)
+	}
+
+	return c.postMethod(ctx, "admin.conversations.restrictAccess.removeGroup", values, &slack.SlackResponse{})
+}
@@ -0,0 +1,64 @@
+package slack
+
+import (
+	"context"
+	"github.com/slack-go/slack"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// conversation has the fields of a conversation object that slack.Channel doesn't have
+type conversation struct {
+	slack.Channel
+	ContextTeamID string   `json:"context_team_id"`
+	SharedTeamIDs []string `json:"shared_team_ids"`
+	PendingShared []string `json:"pending_shared"`
+}
+
+type conversationResponse struct {
+	slack.SlackResponse
+	Channel conversation `json:"channel"`
+}
+
+type conversationsResponse struct {
+	slack.SlackResponse
+	Channels []conversation `json:"channels"`
+}
+
+// https://api.slack.com/methods/conversations.info
+func (c *apiClient) getConversationInfo(ctx context.Context, channelId string) (*conversation, error) {
+	values := url.Values{
+		"channel":             {channelId},
+		"include_num_members": {"true"},
+	}
+
+	response := &conversationResponse{}
+
+	if err := c.postMethod(ctx, "conversations.info", values, response); err != nil {
+		return nil, err
+	}
+
+	return &response.Channel, nil
+}
+
+// https://api.slack.com/methods/conversations.list
+func (c *apiClient) getConversations(ctx context.Context, types []string, excludeArchived bool, limit int, cursor string) ([]conversation, string, error) {
+	values := url.Values{
+		"types":            {strings.Join(types, ",")},
+		"exclude_archived": {strconv.FormatBool(excludeArchived)},
+		"limit":            {strconv.Itoa(limit)},
+	}
+
+	if cursor != "" {
+		values.Add("cursor", cursor)
+	}
+
+	response := &conversationsResponse{}
+
+	if err := c.postMethod(ctx, "conversations.list", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Channels, response.ResponseMetadata.Cursor, nil
+}
@@ -0,0 +1,123 @@
+package slack
+
+import (
+	"context"
+	"github.com/slack-go/slack"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type inviteSharedResponse struct {
+	slack.SlackResponse
+	InviteID              string `json:"invite_id"`
+	IsLegacySharedChannel bool   `json:"is_legacy_shared_channel"`
+}
+
+type connectInvite struct {
+	Direction string `json:"direction"`
+	Status    string `json:"status"`
+	Invite    struct {
+		ID             string `json:"id"`
+		RecipientEmail string `json:"recipient_email"`
+		Link           string `json:"link"`
+	} `json:"invite"`
+	Channel struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		IsPrivate bool   `json:"is_private"`
+	} `json:"channel"`
+}
+
+type connectInvitesResponse struct {
+	slack.SlackResponse
+	Invites []connectInvite `json:"invites"`
+}
+
+// https://api.slack.com/methods/conversations.inviteShared
+func (c *apiClient) inviteShared(ctx context.Context, channelId string, emails []string, userIds []string, externalLimited bool) (string, error) {
+	values := url.Values{
+		"channel":          {channelId},
+		"external_limited": {strconv.FormatBool(externalLimited)},
+	}
+
+	if len(emails) > 0 {
+		values.Add("emails", strings.Join(emails, ","))
+	}
+
+	if len(userIds) > 0 {
+		values.Add("user_ids", strings.Join(userIds, ","))
+	}
+
+	response := &inviteSharedResponse{}
+
+	if err := c.postMethod(ctx, "conversations.inviteShared", values, response); err != nil {
+		return "", err
+	}
+
+	return response.InviteID, nil
+}
+
+// findConnectInvite looks up an invite through all pages of https://api.slack.com/methods/conversations.listConnectInvites
+func (c *apiClient) findConnectInvite(ctx context.Context, inviteId string) (*connectInvite, error) {
+	values := url.Values{
+		"count": {"1000"},
+	}
+
+	for {
+		response := &connectInvitesResponse{}
+
+		if err := c.postMethod(ctx, "conversations.listConnectInvites", values, response); err != nil {
+			return nil, err
+		}
+
+		for _, invite := range response.Invites {
+			if invite.Invite.ID == inviteId {
+				return &invite, nil
+			}
+		}
+
+		if response.ResponseMetadata.Cursor == "" {
+			return nil, nil
+		}
+
+		values.Set("cursor", response.ResponseMetadata.Cursor)
+	}
+}
+
+type acceptSharedInviteResponse struct {
+	slack.SlackResponse
+	ImplicitApproval bool   `json:"implicit_approval"`
+	ChannelID        string `json:"channel_id"`
+	InviteID         string `json:"invite_id"`
+}
+
+// https://api.slack.com/methods/conversations.acceptSharedInvite
+func (c *apiClient) acceptSharedInvite(ctx context.Context, inviteId string, channelName string, isPrivate bool, teamId string) (*acceptSharedInviteResponse, error) {
+	values := url.Values{
+		"invite_id":    {inviteId},
+		"channel_name": {channelName},
+		"is_private":   {strconv.FormatBool(isPrivate)},
+	}
+
+	if teamId != "" {
+		values.Add("team_id", teamId)
+	}
+
+	response := &acceptSharedInviteResponse{}
+
+	if err := c.postMethod(ctx, "conversations.acceptSharedInvite", values, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// https://api.slack.com/methods/conversations.approveSharedInvite
+func (c *apiClient) approveSharedInvite(ctx context.Context, inviteId string) error {
+	values := url.Values{
+		"invite_id": {inviteId},
+	}
+
+	return c.postMethod(ctx, "conversations.approveSharedInvite", values, &slack.SlackResponse{})
+}
@@ -0,0 +1,31 @@
+package slack
+
+import (
+	"context"
+	"github.com/slack-go/slack"
+	"net/url"
+	"strings"
+)
+
+type userGroupResponse struct {
+	slack.SlackResponse
+	UserGroup slack.UserGroup `json:"usergroup"`
+}
+
+// updateUserGroupChannels sends channels and groups even if they are empty to clear the default channels. github.com/slack-go/slack drops an empty list and doesn't support groups.
+// https://api.slack.com/methods/usergroups.update
+func (c *apiClient) updateUserGroupChannels(ctx context.Context, usergroupId string, channelIds []string, groupIds []string) (*slack.UserGroup, error) {
+	values := url.Values{
+		"usergroup": {usergroupId},
+		"channels":  {strings.Join(channelIds, ",")},
+		"groups":    {strings.Join(groupIds, ",")},
+	}
+
+	response := &userGroupResponse{}
+
+	if err := c.postMethod(ctx, "usergroups.update", values, response); err != nil {
+		return nil, err
+	}
+
+	return &response.UserGroup, nil
+}
@@ -9,7 +9,8 @@ import (
 	"time"
 )
 
-const cacheDir = "./.terraform/plugins/.cache/terraform-provider-slack"
+// cacheDir is a variable so that tests can isolate caches
+var cacheDir = "./.terraform/plugins/.cache/terraform-provider-slack"
 
 func saveCacheAsJson(name string, v interface{}) {
 	_ = os.MkdirAll(cacheDir, 0755)
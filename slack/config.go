@@ -1,23 +1,78 @@
 package slack
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/slack-go/slack"
+	"net/http"
+	"sync"
 )
 
 type Config struct {
-	Token string
+	Token      string
+	AdminToken string
 }
 
 type Team struct {
 	client *slack.Client
+	api    *apiClient
 	logger *Logger
+
+	// nil unless an admin token is given
+	adminApi *apiClient
+
+	// caches users.lookupByEmail
+	userIdsByEmail sync.Map
+
+	// caches auth.test
+	tokenOwnerMutex sync.Mutex
+	tokenOwnerId    string
 }
 
 func (c *Config) ProviderContext(version string, commit string) (*Team, error) {
 	var team Team
 
 	team.client = slack.New(c.Token)
+	team.api = newApiClient(c.Token, slack.APIURL, &http.Client{})
+
+	if c.AdminToken != "" {
+		team.adminApi = newApiClient(c.AdminToken, slack.APIURL, &http.Client{})
+	}
 	team.logger = configureLogger(version, commit)
 
 	return &team, nil
 }
+
+func (team *Team) requireAdminApi(summary string) (*apiClient, diag.Diagnostics) {
+	if team.adminApi == nil {
+		return nil, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   "Please set admin_token of the provider or SLACK_ADMIN_TOKEN environment variable to a token of an org admin or owner.",
+			},
+		}
+	}
+
+	return team.adminApi, nil
+}
+
+// getTokenOwnerId returns the user id of the token owner. The owner never changes so it's fetched only once.
+func (team *Team) getTokenOwnerId(ctx context.Context) (string, error) {
+	team.tokenOwnerMutex.Lock()
+	defer team.tokenOwnerMutex.Unlock()
+
+	if team.tokenOwnerId != "" {
+		return team.tokenOwnerId, nil
+	}
+
+	res, err := team.client.AuthTestContext(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	team.tokenOwnerId = res.UserID
+
+	return team.tokenOwnerId, nil
+}
@@ -16,4 +16,29 @@ func Test_Client(t *testing.T) {
 	if team.client == nil {
 		t.Fatalf("required non-nil client")
 	}
+
+	if team.api == nil {
+		t.Fatalf("required non-nil api client")
+	}
+
+	if team.adminApi != nil {
+		t.Fatalf("required nil admin api client without an admin token")
+	}
+}
+
+func Test_ClientWithAdminToken(t *testing.T) {
+	config := &Config{
+		Token:      "test token",
+		AdminToken: "test admin token",
+	}
+
+	team, err := config.ProviderContext("version", "commit")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if team.adminApi == nil {
+		t.Fatalf("required non-nil admin api client")
+	}
 }
@@ -5,20 +5,127 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
+	"strings"
 )
 
+const conversationListCacheFileNameFormat = "conversations_%s.json"
+
+var conversationTypes = []string{"public_channel", "private_channel", "mpim", "im"}
+
+var defaultConversationTypes = []string{"public_channel", "private_channel"}
+
+// conversationAttributesSchema adds read-only attributes of conversations that slack_conversation and the data sources share to the given schema
+func conversationAttributesSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	attributes := map[string]*schema.Schema{
+		"is_shared": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_ext_shared": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_org_shared": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"created": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"creator": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"num_members": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"is_general": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_member": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"context_team_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"shared_team_ids": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"pending_shared": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"topic_creator": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"topic_last_set": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"purpose_creator": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"purpose_last_set": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+
+	for key, value := range attributes {
+		s[key] = value
+	}
+
+	return s
+}
+
 func dataSourceConversation() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSlackConversationRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: conversationAttributesSchema(map[string]*schema.Schema{
 			"channel_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"channel_id", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"channel_id", "name"},
+			},
+			"types": {
+				Type:        schema.TypeSet,
+				Description: "Conversation types to look up by name. Any combination of public_channel, private_channel, mpim and im. public_channel and private_channel by default",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnums(conversationTypes),
+				},
+				ConflictsWith: []string{"channel_id"},
+			},
+			"include_archived": {
+				Type:          schema.TypeBool,
+				Description:   "Whether archived conversations are looked up by name",
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"channel_id"},
 			},
 			"topic": {
 				Type:     schema.TypeString,
@@ -38,32 +145,20 @@ func dataSourceConversation() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"is_shared": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"is_ext_shared": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"is_org_shared": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"created": {
-				Type:     schema.TypeInt,
-				Computed: true,
-			},
-			"creator": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-		},
+		}),
 	}
 }
 
 func dataSlackConversationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Team).client
+	if _, ok := d.GetOk("channel_id"); ok {
+		return dataSlackConversationReadById(ctx, d, meta)
+	}
+
+	return dataSlackConversationReadByName(ctx, d, meta)
+}
+
+func dataSlackConversationReadById(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*Team).api
 	conversationId := d.Get("channel_id").(string)
 
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
@@ -73,7 +168,7 @@ func dataSlackConversationRead(ctx context.Context, d *schema.ResourceData, meta
 
 	logger.trace(ctx, "Start reading a conversation")
 
-	channel, err := client.GetConversationInfoContext(ctx, conversationId, false)
+	channel, err := api.getConversationInfo(ctx, conversationId)
 
 	if err != nil {
 		return diag.Diagnostics{
@@ -87,19 +182,154 @@ func dataSlackConversationRead(ctx context.Context, d *schema.ResourceData, meta
 		logger.trace(ctx, "Got a response from Slack api")
 	}
 
+	configureSlackConversationData(ctx, logger, d, channel)
+
+	return nil
+}
+
+func dataSlackConversationReadByName(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*Team).api
+	name := d.Get("name").(string)
+	includeArchived := d.Get("include_archived").(bool)
+
+	types := schemaSetToStrings(d.Get("types").(*schema.Set))
+
+	if len(types) == 0 {
+		types = defaultConversationTypes
+	}
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"data":              "conversation",
+		"conversation_name": name,
+	})
+
+	logger.trace(ctx, "Start looking up a conversation by name")
+
+	channels, err := getConversationsWithCache(ctx, api, types)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't list conversations to find #%s due to *%s*", name, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.list"),
+			},
+		}
+	} else {
+		logger.trace(ctx, "Got conversations from Slack api or the cache")
+	}
+
+	var matched []conversation
+
+	for _, channel := range channels {
+		if channel.Name != name {
+			continue
+		}
+
+		if channel.IsArchived && !includeArchived {
+			continue
+		}
+
+		matched = append(matched, channel)
+	}
+
+	switch len(matched) {
+	case 0:
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't find a conversation named #%s", name),
+				Detail:   fmt.Sprintf("No conversation matches in %s (include_archived = %t). The token must be able to view the conversation", strings.Join(types, ", "), includeArchived),
+			},
+		}
+	case 1:
+		configureSlackConversationData(ctx, logger, d, &matched[0])
+		return nil
+	default:
+		ids := make([]string, len(matched))
+		for i, channel := range matched {
+			ids[i] = channel.ID
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider found %d conversations named #%s", len(matched), name),
+				Detail:   fmt.Sprintf("%s match the name. Please use channel_id or narrow types and include_archived instead", strings.Join(ids, ", ")),
+			},
+		}
+	}
+}
+
+func configureSlackConversationData(ctx context.Context, logger *Logger, d *schema.ResourceData, channel *conversation) {
 	d.SetId(channel.ID)
-	_ = d.Set("name", channel.Name)
-	_ = d.Set("topic", channel.Topic.Value)
-	_ = d.Set("purpose", channel.Purpose.Value)
-	_ = d.Set("is_private", channel.IsPrivate)
-	_ = d.Set("is_archived", channel.IsArchived)
-	_ = d.Set("is_shared", channel.IsShared)
-	_ = d.Set("is_ext_shared", channel.IsExtShared)
-	_ = d.Set("is_org_shared", channel.IsOrgShared)
-	_ = d.Set("created", channel.Created)
-	_ = d.Set("creator", channel.Creator)
+
+	for key, value := range flattenSlackConversation(channel) {
+		_ = d.Set(key, value)
+	}
 
 	logger.debug(ctx, "Conversation #%s (isArchived = %t)", d.Get("name").(string), d.Get("is_archived").(bool))
+}
 
-	return nil
+func flattenSlackConversation(channel *conversation) map[string]interface{} {
+	return map[string]interface{}{
+		"channel_id":       channel.ID,
+		"name":             channel.Name,
+		"topic":            channel.Topic.Value,
+		"purpose":          channel.Purpose.Value,
+		"is_private":       channel.IsPrivate,
+		"is_archived":      channel.IsArchived,
+		"is_shared":        channel.IsShared,
+		"is_ext_shared":    channel.IsExtShared,
+		"is_org_shared":    channel.IsOrgShared,
+		"created":          int(channel.Created),
+		"creator":          channel.Creator,
+		"num_members":      channel.NumMembers,
+		"is_general":       channel.IsGeneral,
+		"is_member":        channel.IsMember,
+		"context_team_id":  channel.ContextTeamID,
+		"shared_team_ids":  channel.SharedTeamIDs,
+		"pending_shared":   channel.PendingShared,
+		"topic_creator":    channel.Topic.Creator,
+		"topic_last_set":   int(channel.Topic.LastSet),
+		"purpose_creator":  channel.Purpose.Creator,
+		"purpose_last_set": int(channel.Purpose.LastSet),
+	}
+}
+
+// getConversationsWithCache returns all conversations of the given types including archived ones.
+// Use a cache for conversations api call because listing all conversations needs many requests
+func getConversationsWithCache(ctx context.Context, api *apiClient, types []string) ([]conversation, error) {
+	sortedTypes := append([]string{}, types...)
+	sort.Strings(sortedTypes)
+
+	cacheFileName := fmt.Sprintf(conversationListCacheFileNameFormat, strings.Join(sortedTypes, "_"))
+
+	var channels []conversation
+
+	if restoreJsonCache(cacheFileName, &channels) {
+		return channels, nil
+	}
+
+	cursor := ""
+
+	for {
+		page, nextCursor, err := api.getConversations(ctx, sortedTypes, false, 1000, cursor)
+
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, page...)
+
+		if nextCursor == "" {
+			break
+		}
+
+		cursor = nextCursor
+	}
+
+	saveCacheAsJson(cacheFileName, &channels)
+
+	return channels, nil
 }
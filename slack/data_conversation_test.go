@@ -0,0 +1,195 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+type conversationsListResponse struct {
+	slack.SlackResponse
+	Channels []slack.Channel `json:"channels"`
+}
+
+func testChannel(id string, name string, isArchived bool) slack.Channel {
+	channel := slack.Channel{}
+	channel.ID = id
+	channel.Name = name
+	channel.IsArchived = isArchived
+	return channel
+}
+
+var testChannels = []slack.Channel{
+	testChannel("C0001", "general", false),
+	testChannel("C0002", "duplicated", false),
+	testChannel("C0003", "duplicated", false),
+	testChannel("C0004", "archived", true),
+}
+
+func Test_DataConversationReadByName(t *testing.T) {
+	cases := []struct {
+		Name            string
+		IncludeArchived bool
+		ExpectId        string
+	}{
+		{
+			Name:     "general",
+			ExpectId: "C0001",
+		},
+		{
+			Name:     "duplicated",
+			ExpectId: "",
+		},
+		{
+			Name:     "archived",
+			ExpectId: "",
+		},
+		{
+			Name:            "archived",
+			IncludeArchived: true,
+			ExpectId:        "C0004",
+		},
+		{
+			Name:     "missing",
+			ExpectId: "",
+		},
+	}
+
+	for _, tc := range cases {
+		d := dataSourceConversation().TestResourceData()
+		if err := d.Set("name", tc.Name); err != nil {
+			t.Fatalf("err set name: %s", err)
+		}
+		if err := d.Set("include_archived", tc.IncludeArchived); err != nil {
+			t.Fatalf("err set include_archived: %s", err)
+		}
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.list",
+				Response: conversationsListResponse{
+					slack.SlackResponse{Ok: true},
+					testChannels,
+				},
+			},
+		})
+
+		diags := dataSlackConversationRead(ctx, d, team)
+
+		if tc.ExpectId == "" {
+			if !diags.HasError() {
+				t.Fatalf("expect an error for #%s but got %s", tc.Name, d.Id())
+			}
+			continue
+		}
+
+		if diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != tc.ExpectId || d.Get("channel_id").(string) != tc.ExpectId {
+			t.Fatalf("expect id to be %s, but got %s", tc.ExpectId, d.Id())
+		}
+	}
+}
+
+func Test_DataConversationsRead(t *testing.T) {
+	cases := []struct {
+		Raw       map[string]interface{}
+		ExpectIds []string
+	}{
+		{
+			// archived conversations are excluded by default
+			Raw:       map[string]interface{}{},
+			ExpectIds: []string{"C0001", "C0002", "C0003"},
+		},
+		{
+			Raw: map[string]interface{}{
+				"exclude_archived": false,
+			},
+			ExpectIds: []string{"C0001", "C0002", "C0003", "C0004"},
+		},
+		{
+			Raw: map[string]interface{}{
+				"name_regex": "^dup",
+			},
+			ExpectIds: []string{"C0002", "C0003"},
+		},
+		{
+			Raw: map[string]interface{}{
+				"is_ext_shared": "false",
+			},
+			ExpectIds: []string{"C0001", "C0002", "C0003"},
+		},
+		{
+			Raw: map[string]interface{}{
+				"is_ext_shared": "true",
+			},
+			ExpectIds: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceConversations().Schema, tc.Raw)
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.list",
+				Response: conversationsListResponse{
+					slack.SlackResponse{Ok: true},
+					testChannels,
+				},
+			},
+		})
+
+		if diags := dataSlackConversationsRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		conversations := d.Get("conversations").([]interface{})
+		if len(tc.ExpectIds) != len(conversations) {
+			t.Fatalf("expect %v conversations but got %v", len(tc.ExpectIds), len(conversations))
+		}
+		for i, c := range conversations {
+			if id := c.(map[string]interface{})["channel_id"].(string); id != tc.ExpectIds[i] {
+				t.Fatalf("expect %s but got %s", tc.ExpectIds[i], id)
+			}
+		}
+	}
+}
+
+func Test_DataConversationReadById(t *testing.T) {
+	d := dataSourceConversation().TestResourceData()
+	if err := d.Set("channel_id", "C0005"); err != nil {
+		t.Fatalf("err set channel_id: %s", err)
+	}
+
+	channel := conversation{
+		Channel:       testChannel("C0005", "shared", false),
+		SharedTeamIDs: []string{"T0001", "T0002"},
+	}
+	channel.NumMembers = 501
+	channel.IsExtShared = true
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.info",
+			Response: conversationResponse{
+				slack.SlackResponse{Ok: true},
+				channel,
+			},
+		},
+	})
+
+	if diags := dataSlackConversationRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if numMembers := d.Get("num_members").(int); numMembers != 501 {
+		t.Fatalf("expect 501 members but got %d", numMembers)
+	}
+
+	if sharedTeamIds := d.Get("shared_team_ids").([]interface{}); len(sharedTeamIds) != 2 {
+		t.Fatalf("expect 2 shared teams but got %d", len(sharedTeamIds))
+	}
+}
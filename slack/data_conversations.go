@@ -0,0 +1,158 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// conversationsFilterAny disables a filter
+const conversationsFilterAny = "any"
+
+func dataSourceConversations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSlackConversationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Type:        schema.TypeSet,
+				Description: "Any combination of public_channel, private_channel, mpim and im. public_channel and private_channel by default",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnums(conversationTypes),
+				},
+			},
+			"exclude_archived": {
+				Type:        schema.TypeBool,
+				Description: "Whether to exclude archived conversations",
+				Optional:    true,
+				Default:     true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regex that names of conversations must match",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"is_ext_shared": {
+				Type:         schema.TypeString,
+				Description:  "Filter conversations by whether they are shared with external organizations. Either of true, false or any",
+				Optional:     true,
+				Default:      conversationsFilterAny,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false", conversationsFilterAny}, false),
+			},
+			"creator": {
+				Type:        schema.TypeString,
+				Description: "A user id who created conversations",
+				Optional:    true,
+			},
+			"conversations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: conversationAttributesSchema(map[string]*schema.Schema{
+						"channel_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purpose": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_private": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_archived": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func dataSlackConversationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*Team).api
+	excludeArchived := d.Get("exclude_archived").(bool)
+	nameRegex := d.Get("name_regex").(string)
+	creator := d.Get("creator").(string)
+	isExtShared := d.Get("is_ext_shared").(string)
+
+	types := schemaSetToStrings(d.Get("types").(*schema.Set))
+
+	if len(types) == 0 {
+		types = defaultConversationTypes
+	}
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"data":       "conversations",
+		"types":      strings.Join(types, ","),
+		"name_regex": nameRegex,
+	})
+
+	logger.trace(ctx, "Start listing conversations")
+
+	channels, err := getConversationsWithCache(ctx, api, types)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't list conversations due to *%s*", err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.list"),
+			},
+		}
+	} else {
+		logger.trace(ctx, "Got conversations from Slack api or the cache")
+	}
+
+	// validated by the schema
+	nameMatcher := regexp.MustCompile(nameRegex)
+
+	var conversations []map[string]interface{}
+
+	for _, channel := range channels {
+		if excludeArchived && channel.IsArchived {
+			continue
+		}
+
+		if !nameMatcher.MatchString(channel.Name) {
+			continue
+		}
+
+		if isExtShared != conversationsFilterAny && strconv.FormatBool(channel.IsExtShared) != isExtShared {
+			continue
+		}
+
+		if creator != "" && channel.Creator != creator {
+			continue
+		}
+
+		channel := channel
+		conversations = append(conversations, flattenSlackConversation(&channel))
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(fmt.Sprintf("%v/%t/%s/%s/%s", types, excludeArchived, nameRegex, isExtShared, creator))))
+	_ = d.Set("conversations", conversations)
+
+	logger.debug(ctx, "Found %d conversations", len(conversations))
+
+	return nil
+}
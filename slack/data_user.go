@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/slack-go/slack"
+	"sort"
+	"sync"
 )
 
 const (
@@ -13,6 +15,9 @@ const (
 	userQueryTypeID       = "id"
 	userQueryTypeName     = "name"
 	userQueryTypeEmail    = "email"
+
+	// users.lookupByEmail is Tier 3 so don't send too many requests at once
+	userLookupByEmailConcurrency = 5
 )
 
 func dataSourceSlackUser() *schema.Resource {
@@ -128,40 +133,21 @@ func dataSourceSlackUserRead(ctx context.Context, d *schema.ResourceData, meta i
 	if queryType == userQueryTypeName {
 		logger.trace(ctx, "Start reading the slack user by user_name")
 
-		// Use a cache for users api call because the limitation is stricter than user.info
-		var users *[]slack.User
-
-		if !restoreJsonCache(userListCacheFileName, &users) {
-			tempUsers, err := client.GetUsersContext(ctx)
-
-			if err != nil {
-				return diag.Diagnostics{
-					{
-						Severity: diag.Error,
-						Summary:  fmt.Sprintf("Slack provider couldn't find a slack user (%s) due to *%s*", queryValue, err.Error()),
-						Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.list"),
-					},
-				}
-			} else {
-				logger.trace(ctx, "Got a response from Slack api")
-			}
-
-			users = &tempUsers
-
-			saveCacheAsJson(userListCacheFileName, &users)
-		}
+		users, err := getUsersWithCache(ctx, client)
 
-		if users == nil {
+		if err != nil {
 			return diag.Diagnostics{
 				{
 					Severity: diag.Error,
-					Summary:  fmt.Sprintf("Serious error happened while finding a slack user (%s)", queryValue),
-					Detail:   "Please use another query_type or open an issue at https://github.com/jmatsu/terraform-provider-slack",
+					Summary:  fmt.Sprintf("Slack provider couldn't find a slack user (%s) due to *%s*", queryValue, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.list"),
 				},
 			}
+		} else {
+			logger.trace(ctx, "Got users from Slack api or the cache")
 		}
 
-		for _, user := range *users {
+		for _, user := range users {
 			if dataSourceSlackUserMatch(&user, queryType, queryValue) {
 				logger.debug(ctx, "Found a user")
 
@@ -201,3 +187,77 @@ func dataSourceSlackUserMatch(user *slack.User, queryType string, queryValue str
 	}
 	return false
 }
+
+// getUsersWithCache returns all users of the workspace.
+// Use a cache for users api call because the limitation is stricter than user.info
+func getUsersWithCache(ctx context.Context, client *slack.Client) ([]slack.User, error) {
+	var users []slack.User
+
+	if restoreJsonCache(userListCacheFileName, &users) {
+		return users, nil
+	}
+
+	users, err := client.GetUsersContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	saveCacheAsJson(userListCacheFileName, &users)
+
+	return users, nil
+}
+
+// lookupUserIdsByEmails resolves emails to user ids through users.lookupByEmail concurrently. Resolved ids are cached while the provider is running.
+// Returns emails that no user has as well so that callers can report all of them at once
+func lookupUserIdsByEmails(ctx context.Context, team *Team, emails []string) (map[string]string, []string, error) {
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+
+	idsByEmail := map[string]string{}
+	var unknown []string
+
+	semaphore := make(chan struct{}, userLookupByEmailConcurrency)
+
+	for _, email := range emails {
+		if id, ok := team.userIdsByEmail.Load(email); ok {
+			idsByEmail[email] = id.(string)
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(email string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			user, err := team.client.GetUserByEmailContext(ctx, email)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			switch {
+			case err == nil:
+				team.userIdsByEmail.Store(email, user.ID)
+				idsByEmail[email] = user.ID
+			case err.Error() == "users_not_found":
+				unknown = append(unknown, email)
+			case firstErr == nil:
+				firstErr = err
+			}
+		}(email)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+
+	sort.Strings(unknown)
+
+	return idsByEmail, unknown, nil
+}
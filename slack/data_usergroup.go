@@ -14,16 +14,35 @@ func dataSourceUserGroup() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"usergroup_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"usergroup_id", "handle", "name"},
 			},
 			"handle": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Description:  "A mention name of the usergroup without @",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"usergroup_id", "handle", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"usergroup_id", "handle", "name"},
+			},
+			"include_disabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether to look up disabled usergroups by handle or name. Disabled usergroups are always found by usergroup_id",
+				Optional:    true,
+				Default:     false,
+			},
+			"include_users": {
+				Type:        schema.TypeBool,
+				Description: "Whether to read users of the usergroup",
+				Optional:    true,
+				Default:     false,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -38,58 +57,224 @@ func dataSourceUserGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"users": {
+				Type:        schema.TypeSet,
+				Description: "User ids of the usergroup. Available only if include_users is true",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"channels": {
+				Type:        schema.TypeSet,
+				Description: "Default channels and private channels of the usergroup",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_external": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"date_create": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_update": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_delete": {
+				Type:        schema.TypeInt,
+				Description: "0 unless the usergroup is disabled",
+				Computed:    true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSlackUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	usergroupId := d.Get("usergroup_id").(string)
+	handle := d.Get("handle").(string)
+	name := d.Get("name").(string)
 
 	client := meta.(*Team).client
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
-		"data":         "slack_usergroup",
-		"usergroup_id": usergroupId,
+		"data":             "slack_usergroup",
+		"usergroup_id":     usergroupId,
+		"usergroup_handle": handle,
+		"usergroup_name":   name,
 	})
 
 	logger.trace(ctx, "Start reading a usergroup")
 
-	groups, err := client.GetUserGroupsContext(ctx, func(params *slack.GetUserGroupsParams) {
-		params.IncludeUsers = false
-		params.IncludeCount = false
-		params.IncludeDisabled = true
-	})
+	// Use a cache for usergroups api call because the limitation is strict
+	groups, err := getUserGroupsWithCache(ctx, client)
 
 	if err != nil {
 		return diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("provicer cannot find a usergroup (%s) due to *%s*", usergroupId, err.Error()),
+				Summary:  fmt.Sprintf("provider cannot read usergroups due to *%s*", err.Error()),
 				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.list"),
 			},
 		}
+	}
+
+	var group *slack.UserGroup
+	var diags diag.Diagnostics
+
+	if usergroupId != "" {
+		group = findUserGroupById(groups, usergroupId)
+
+		if group == nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("provider cannot find a usergroup (%s)", usergroupId),
+					Detail:   fmt.Sprintf("a usergroup (%s) is not found in available usergroups that this token can view", usergroupId),
+				},
+			}
+		}
 	} else {
-		logger.trace(ctx, "Got a response from Slack api")
+		group, diags = findUserGroupByHandleOrName(groups, handle, name, d.Get("include_disabled").(bool))
+
+		if diags.HasError() {
+			return diags
+		}
 	}
 
+	if d.Get("include_users").(bool) {
+		users, err := client.GetUserGroupMembersContext(ctx, group.ID)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("provider cannot read users of a usergroup (%s) due to *%s*", group.ID, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.list"),
+				},
+			}
+		} else {
+			logger.trace(ctx, "Got a response from Slack api")
+		}
+
+		_ = d.Set("users", users)
+	}
+
+	d.SetId(group.ID)
+	_ = d.Set("usergroup_id", group.ID)
+	_ = d.Set("handle", group.Handle)
+	_ = d.Set("name", group.Name)
+	_ = d.Set("description", group.Description)
+	_ = d.Set("auto_type", group.AutoType)
+	_ = d.Set("team_id", group.TeamID)
+	_ = d.Set("channels", append(group.Prefs.Channels, group.Prefs.Groups...))
+	_ = d.Set("user_count", group.UserCount)
+	_ = d.Set("is_external", group.IsExternal)
+	_ = d.Set("date_create", int(group.DateCreate))
+	_ = d.Set("date_update", int(group.DateUpdate))
+	_ = d.Set("date_delete", int(group.DateDelete))
+	_ = d.Set("created_by", group.CreatedBy)
+	_ = d.Set("updated_by", group.UpdatedBy)
+
+	logger.debug(ctx, "UserGroup @%s", d.Get("handle").(string))
+
+	return diags
+}
+
+func findUserGroupById(groups []slack.UserGroup, id string) *slack.UserGroup {
+	for i := range groups {
+		if groups[i].ID == id {
+			return &groups[i]
+		}
+	}
+
+	return nil
+}
+
+// findUserGroupByHandleOrName prefers enabled usergroups because disabled ones may share the handle with an enabled one
+func findUserGroupByHandleOrName(groups []slack.UserGroup, handle string, name string, includeDisabled bool) (*slack.UserGroup, diag.Diagnostics) {
+	var key string
+
+	if handle != "" {
+		key = "@" + handle
+	} else {
+		key = name
+	}
+
+	var enabled []slack.UserGroup
+	var disabled []slack.UserGroup
+
 	for _, group := range groups {
-		if group.ID == usergroupId {
-			d.SetId(group.ID)
-			_ = d.Set("handle", group.Handle)
-			_ = d.Set("name", group.Name)
-			_ = d.Set("description", group.Description)
-			_ = d.Set("auto_type", group.AutoType)
-			_ = d.Set("team_id", group.TeamID)
-
-			logger.debug(ctx, "UserGroup @%s", d.Get("handle").(string))
-			return nil
+		if (handle != "" && group.Handle != handle) || (name != "" && group.Name != name) {
+			continue
+		}
+
+		if group.DateDelete == 0 {
+			enabled = append(enabled, group)
+		} else {
+			disabled = append(disabled, group)
 		}
 	}
 
-	return diag.Diagnostics{
-		{
-			Severity: diag.Error,
-			Summary:  fmt.Sprintf("provicer cannot find a usergroup (%s)", usergroupId),
-			Detail:   fmt.Sprintf("a usergroup (%s) is not found in available usergroups that this token can view", usergroupId),
-		},
+	candidates := enabled
+
+	if len(candidates) == 0 && includeDisabled {
+		candidates = disabled
+	}
+
+	switch len(candidates) {
+	case 1:
+		if candidates[0].DateDelete != 0 {
+			return &candidates[0], diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("a usergroup (%s) is disabled", key),
+					Detail:   fmt.Sprintf("a usergroup (%s) has been disabled so its members won't be mentioned", candidates[0].ID),
+				},
+			}
+		}
+
+		return &candidates[0], nil
+	case 0:
+		if len(disabled) > 0 {
+			return nil, diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("provider cannot find an enabled usergroup (%s)", key),
+					Detail:   fmt.Sprintf("a usergroup (%s) is disabled. Please set include_disabled to true to look up disabled usergroups", disabled[0].ID),
+				},
+			}
+		}
+
+		return nil, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("provider cannot find a usergroup (%s)", key),
+				Detail:   fmt.Sprintf("a usergroup (%s) is not found in available usergroups that this token can view", key),
+			},
+		}
+	default:
+		return nil, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("provider found %d usergroups (%s)", len(candidates), key),
+				Detail:   "Please use usergroup_id or handle to identify the usergroup",
+			},
+		}
 	}
 }
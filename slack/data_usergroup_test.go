@@ -0,0 +1,147 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+type userGroupsListResponse struct {
+	slack.SlackResponse
+	UserGroups []slack.UserGroup `json:"usergroups"`
+}
+
+// testUserGroups is shared by tests that read usergroups.list
+var testUserGroups = []slack.UserGroup{
+	{
+		ID:         "S0001",
+		Handle:     "sre-oncall",
+		Name:       "SRE oncall",
+		DateCreate: 1500000000,
+		CreatedBy:  "U0001",
+		Prefs:      slack.UserGroupPrefs{Channels: []string{"C0001"}, Groups: []string{"G0001"}},
+		UserCount:  2,
+	},
+	{ID: "S0002", Handle: "sre-oncall", Name: "SRE oncall (old)", DateDelete: 1600000000},
+	{ID: "S0003", Handle: "legacy", Name: "Legacy", DateDelete: 1600000000},
+	{ID: "S0004", Handle: "ios", Name: "Mobile"},
+	{ID: "S0005", Handle: "android", Name: "Mobile"},
+}
+
+func Test_DataUserGroupRead(t *testing.T) {
+	cases := []struct {
+		Attributes    map[string]interface{}
+		ExpectId      string
+		ExpectError   bool
+		ExpectWarning bool
+	}{
+		{
+			Attributes: map[string]interface{}{"usergroup_id": "S0003"},
+			ExpectId:   "S0003",
+		},
+		{
+			Attributes: map[string]interface{}{"handle": "sre-oncall"},
+			ExpectId:   "S0001",
+		},
+		{
+			Attributes: map[string]interface{}{"name": "SRE oncall"},
+			ExpectId:   "S0001",
+		},
+		{
+			Attributes:  map[string]interface{}{"handle": "legacy"},
+			ExpectError: true,
+		},
+		{
+			Attributes:    map[string]interface{}{"handle": "legacy", "include_disabled": true},
+			ExpectId:      "S0003",
+			ExpectWarning: true,
+		},
+		{
+			Attributes:  map[string]interface{}{"name": "Mobile"},
+			ExpectError: true,
+		},
+		{
+			Attributes:  map[string]interface{}{"handle": "unknown"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := dataSourceUserGroup().TestResourceData()
+
+		for k, v := range tc.Attributes {
+			_ = d.Set(k, v)
+		}
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/usergroups.list",
+				Response: userGroupsListResponse{
+					slack.SlackResponse{Ok: true},
+					testUserGroups,
+				},
+			},
+		})
+
+		diags := dataSlackUserGroupRead(ctx, d, team)
+
+		if diags.HasError() != tc.ExpectError {
+			t.Fatalf("%v: expect an error to be %t, but got %v", tc.Attributes, tc.ExpectError, diags)
+		}
+
+		if tc.ExpectError {
+			continue
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("%v: expect id to be %s, but got %s", tc.Attributes, tc.ExpectId, d.Id())
+		}
+
+		if (len(diags) > 0) != tc.ExpectWarning {
+			t.Fatalf("%v: expect a warning to be %t, but got %v", tc.Attributes, tc.ExpectWarning, diags)
+		}
+	}
+}
+
+func Test_DataUserGroupReadWithUsers(t *testing.T) {
+	d := dataSourceUserGroup().TestResourceData()
+	_ = d.Set("handle", "sre-oncall")
+	_ = d.Set("include_users", true)
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.list",
+			Response: userGroupsListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroups,
+			},
+		},
+		{
+			Path: "/usergroups.users.list",
+			Response: userGroupUsersListResponse{
+				slack.SlackResponse{Ok: true},
+				[]string{"U0001", "U0002"},
+			},
+		},
+	})
+
+	if diags := dataSlackUserGroupRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if users := d.Get("users").(*schema.Set); users.Len() != 2 {
+		t.Fatalf("expect 2 users, but got %v", users.List())
+	}
+
+	if channels := d.Get("channels").(*schema.Set); channels.Len() != 2 || !channels.Contains("G0001") {
+		t.Fatalf("expect channels and private channels, but got %v", channels.List())
+	}
+
+	if count := d.Get("user_count").(int); count != 2 {
+		t.Fatalf("expect user_count to be 2, but got %d", count)
+	}
+
+	if createdAt := d.Get("date_create").(int); createdAt != 1500000000 {
+		t.Fatalf("expect date_create to be 1500000000, but got %d", createdAt)
+	}
+}
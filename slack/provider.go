@@ -10,7 +10,8 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
-		"token": "The OAuth token used to connect to Slack.",
+		"token":       "The OAuth token used to connect to Slack.",
+		"admin_token": "The OAuth token of an org admin or owner. It's required only by features that use admin.* API methods on Enterprise Grid.",
 	}
 
 	schema.DescriptionKind = schema.StringMarkdown
@@ -26,19 +27,37 @@ func New(version string, commit string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("SLACK_TOKEN", nil),
 					Description: descriptions["token"],
 				},
+				"admin_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("SLACK_ADMIN_TOKEN", nil),
+					Description: descriptions["admin_token"],
+				},
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
-				"slack_user":         dataSourceSlackUser(),
-				"slack_usergroup":    dataSourceUserGroup(),
-				"slack_conversation": dataSourceConversation(),
+				"slack_user":          dataSourceSlackUser(),
+				"slack_usergroup":     dataSourceUserGroup(),
+				"slack_conversation":  dataSourceConversation(),
+				"slack_conversations": dataSourceConversations(),
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
-				"slack_usergroup":          resourceSlackUserGroup(),
-				"slack_usergroup_members":  resourceSlackUserGroupMembers(),
-				"slack_conversation":       resourceSlackConversation(),
-				"slack_usergroup_channels": resourceSlackUserGroupChannels(),
+				"slack_usergroup":                             resourceSlackUserGroup(),
+				"slack_usergroup_members":                     resourceSlackUserGroupMembers(),
+				"slack_usergroup_member":                      resourceSlackUserGroupMember(),
+				"slack_conversation":                          resourceSlackConversation(),
+				"slack_usergroup_channels":                    resourceSlackUserGroupChannels(),
+				"slack_conversation_members":                  resourceSlackConversationMembers(),
+				"slack_conversation_member":                   resourceSlackConversationMember(),
+				"slack_conversation_retention":                resourceSlackConversationRetention(),
+				"slack_conversation_shared_invite":            resourceSlackConversationSharedInvite(),
+				"slack_conversation_shared_invite_acceptance": resourceSlackConversationSharedInviteAcceptance(),
+				"slack_conversation_teams":                    resourceSlackConversationTeams(),
+				"slack_conversation_idp_groups":               resourceSlackConversationIdpGroups(),
+				"slack_conversation_bookmark":                 resourceSlackConversationBookmark(),
+				"slack_conversation_pin":                      resourceSlackConversationPin(),
 			},
 		}
 
@@ -51,7 +70,8 @@ func New(version string, commit string) func() *schema.Provider {
 func configureProvider(version string, commit string) schema.ConfigureContextFunc {
 	return func(context context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		config := Config{
-			Token: d.Get("token").(string),
+			Token:      d.Get("token").(string),
+			AdminToken: d.Get("admin_token").(string),
 		}
 
 		meta, err := config.ProviderContext(version, commit)
@@ -6,7 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/slack-go/slack"
+	"time"
 )
 
 const (
@@ -27,10 +27,19 @@ func resourceSlackConversation() *schema.Resource {
 		DeleteContext: resourceSlackConversationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importSlackConversation,
 		},
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: customizeDiffSlackConversation,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: conversationAttributesSchema(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -52,37 +61,89 @@ func resourceSlackConversation() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"org_wide": {
+				Type:          schema.TypeBool,
+				Description:   "Whether to create the conversation across all workspaces in the org. Changing it recreates the conversation and admin_token of the provider is required",
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"team_id"},
+			},
+			"team_id": {
+				Type:          schema.TypeString,
+				Description:   "A workspace to create the conversation in on Enterprise Grid. Changing it recreates the conversation and admin_token of the provider is required",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"org_wide"},
+			},
+			"ensure_bot_membership": {
+				Type:        schema.TypeBool,
+				Description: "Whether to join the public conversation before changing it. The token owner cannot join private conversations by itself",
+				Optional:    true,
+				Default:     false,
+			},
 			"action_on_destroy": {
 				Type:         schema.TypeString,
 				Description:  "Either of none or archive",
 				Required:     true,
 				ValidateFunc: validateConversationActionOnDestroyValue,
 			},
-			"is_shared": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"is_ext_shared": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"is_org_shared": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"created": {
-				Type:     schema.TypeInt,
-				Computed: true,
-			},
-			"creator": {
-				Type:     schema.TypeString,
-				Computed: true,
+			"posting_restrictions": {
+				Type:        schema.TypeList,
+				Description: "Who can post and reply in threads. admin_token of the provider is required",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"who_can_post_types": {
+							Type:        schema.TypeSet,
+							Description: "User types who can post. e.g. admin, owner and ra",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"who_can_post_users": {
+							Type:        schema.TypeSet,
+							Description: "User ids who can post",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"who_can_post_usergroups": {
+							Type:        schema.TypeSet,
+							Description: "Usergroup ids whose members can post",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"can_thread_types": {
+							Type:        schema.TypeSet,
+							Description: "User types who can reply in threads",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"can_thread_users": {
+							Type:        schema.TypeSet,
+							Description: "User ids who can reply in threads",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
 			},
-		},
+		}),
 	}
 }
 
-func configureSlackConversation(ctx context.Context, logger *Logger, d *schema.ResourceData, channel *slack.Channel) {
+func configureSlackConversation(ctx context.Context, logger *Logger, d *schema.ResourceData, channel *conversation) {
 	d.SetId(channel.ID)
 	_ = d.Set("name", channel.Name)
 	_ = d.Set("topic", channel.Topic.Value)
@@ -93,13 +154,26 @@ func configureSlackConversation(ctx context.Context, logger *Logger, d *schema.R
 	_ = d.Set("is_org_shared", channel.IsOrgShared)
 	_ = d.Set("created", channel.Created)
 	_ = d.Set("creator", channel.Creator)
+	_ = d.Set("num_members", channel.NumMembers)
+	_ = d.Set("is_general", channel.IsGeneral)
+	_ = d.Set("is_member", channel.IsMember)
+	_ = d.Set("context_team_id", channel.ContextTeamID)
+	_ = d.Set("shared_team_ids", channel.SharedTeamIDs)
+	_ = d.Set("pending_shared", channel.PendingShared)
+	_ = d.Set("topic_creator", channel.Topic.Creator)
+	_ = d.Set("topic_last_set", int(channel.Topic.LastSet))
+	_ = d.Set("purpose_creator", channel.Purpose.Creator)
+	_ = d.Set("purpose_last_set", int(channel.Purpose.LastSet))
 
 	// Required
 	_ = d.Set("is_private", channel.IsPrivate)
 
-	// Never support
+	// Read them so that imported conversations are not recreated
+	_ = d.Set("org_wide", channel.IsOrgShared)
+	_ = d.Set("team_id", channel.ContextTeamID)
+
+	// Never support. Use slack_conversation_members to manage members instead
 	//_ = d.Set("members", channel.Members)
-	//_ = d.Set("num_members", channel.NumMembers)
 	//_ = d.Set("unread_count", channel.UnreadCount)
 	//_ = d.Set("unread_count_display", channel.UnreadCountDisplay)
 	//_ = d.Set("last_read", channel.Name)
@@ -121,29 +195,104 @@ func resourceSlackConversationCreate(ctx context.Context, d *schema.ResourceData
 
 	logger.trace(ctx, "Start creating a conversation")
 
-	channel, err := client.CreateConversationContext(ctx, name, isPrivate)
+	orgWide := d.Get("org_wide").(bool)
+	teamId := d.Get("team_id").(string)
 
-	if err != nil {
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Slack provider couldn't create a slack conversation (%s, isPrivate = %t) due to *%s*", name, isPrivate, err.Error()),
-				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.create"),
-			},
+	var channel *conversation
+	var warnings diag.Diagnostics
+
+	if orgWide || teamId != "" {
+		logger.debug(ctx, "Create the conversation on Enterprise Grid (orgWide = %t, teamId = %s)", orgWide, teamId)
+
+		adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't create a slack conversation (%s) on Enterprise Grid without an admin token", name))
+
+		if diags.HasError() {
+			return diags
+		}
+
+		channelId, err := adminApi.createConversation(ctx, name, isPrivate, orgWide, teamId)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't create a slack conversation (%s, isPrivate = %t) on Enterprise Grid due to *%s*", name, isPrivate, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.create"),
+				},
+			}
+		} else {
+			logger.trace(ctx, "Got a response from Slack API")
+		}
+
+		// Keep the conversation in the state even if it cannot be read below. Otherwise the next apply fails with name_taken
+		d.SetId(channelId)
+
+		if channel, err = getSlackConversationInfo(ctx, meta, channelId); err != nil {
+			if err.Error() != "channel_not_found" {
+				return diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Slack provider created but couldn't read a slack conversation (%s) due to *%s*", channelId, err.Error()),
+						Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.info"),
+					},
+				}
+			}
+
+			logger.debug(ctx, "Neither token can view the created conversation yet")
+
+			warnings = diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Slack provider created a slack conversation (%s) but couldn't read its details yet", channelId),
+					Detail:   "Neither the token nor admin_token can view the conversation. Please invite the token owner to the conversation so that the details are read on the next refresh.",
+				},
+			}
 		}
 	} else {
-		logger.trace(ctx, "Got a response from Slack API")
+		created, err := client.CreateConversationContext(ctx, name, isPrivate)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't create a slack conversation (%s, isPrivate = %t) due to *%s*", name, isPrivate, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.create"),
+				},
+			}
+		} else {
+			logger.trace(ctx, "Got a response from Slack API")
+		}
+
+		channel = &conversation{Channel: *created}
 	}
 
-	configureSlackConversation(ctx, logger, d, channel)
+	if channel != nil {
+		configureSlackConversation(ctx, logger, d, channel)
+	}
 
-	return nil
+	if _, ok := d.GetOk("posting_restrictions"); ok {
+		if diags := updateSlackConversationPostingRestrictions(ctx, logger, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	return warnings
+}
+
+// getSlackConversationInfo falls back to admin_token because the token owner is usually not a member of conversations created by the admin api
+func getSlackConversationInfo(ctx context.Context, meta interface{}, channelId string) (*conversation, error) {
+	channel, err := meta.(*Team).api.getConversationInfo(ctx, channelId)
+
+	if err != nil && err.Error() == "channel_not_found" && meta.(*Team).adminApi != nil {
+		return meta.(*Team).adminApi.getConversationInfo(ctx, channelId)
+	}
+
+	return channel, err
 }
 
 func resourceSlackConversationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	client := meta.(*Team).client
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
 		"resource":        "slack_conversation",
 		"conversation_id": id,
@@ -151,7 +300,19 @@ func resourceSlackConversationRead(ctx context.Context, d *schema.ResourceData,
 
 	logger.trace(ctx, "Start reading the conversation")
 
-	channel, err := client.GetConversationInfoContext(ctx, id, false)
+	channel, err := getSlackConversationInfo(ctx, meta, id)
+
+	if err != nil && err.Error() == "channel_not_found" && d.Get("created").(int) == 0 {
+		logger.debug(ctx, "Neither token has been able to view the conversation since it was created so keep the previous state")
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Slack provider couldn't read a slack conversation (%s) that the admin api created", id),
+				Detail:   "Neither the token nor admin_token can view the conversation. Please invite the token owner to the conversation so that the details are read on the next refresh.",
+			},
+		}
+	}
 
 	if err != nil {
 		return diag.Diagnostics{
@@ -167,9 +328,42 @@ func resourceSlackConversationRead(ctx context.Context, d *schema.ResourceData,
 
 	configureSlackConversation(ctx, logger, d, channel)
 
+	if _, ok := d.GetOk("posting_restrictions"); ok && meta.(*Team).adminApi != nil {
+		prefs, err := meta.(*Team).adminApi.getConversationPrefs(ctx, id)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't read posting restrictions of a slack conversation (%s) due to *%s*", id, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.getConversationPrefs"),
+				},
+			}
+		}
+
+		_ = d.Set("posting_restrictions", flattenConversationPrefs(prefs))
+	}
+
 	return nil
 }
 
+// importSlackConversation reads posting restrictions because Read reads them only if they are in the state
+func importSlackConversation(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if adminApi := meta.(*Team).adminApi; adminApi != nil {
+		prefs, err := adminApi.getConversationPrefs(ctx, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !prefs.isEmpty() {
+			_ = d.Set("posting_restrictions", flattenConversationPrefs(prefs))
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceSlackConversationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
@@ -179,6 +373,10 @@ func resourceSlackConversationUpdate(ctx context.Context, d *schema.ResourceData
 		"conversation_id": id,
 	})
 
+	if diags := ensureSlackConversationMembership(ctx, logger, d, meta); diags.HasError() {
+		return diags
+	}
+
 	// TODO check if it's changed or not to reduce api calls
 
 	name := d.Get("name").(string)
@@ -221,6 +419,12 @@ func resourceSlackConversationUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if d.HasChange("posting_restrictions") {
+		if diags := updateSlackConversationPostingRestrictions(ctx, logger, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+
 	if isArchived, ok := d.GetOkExists("is_archived"); ok {
 		if isArchived.(bool) {
 			if err := client.ArchiveConversationContext(ctx, id); err != nil {
@@ -277,6 +481,10 @@ func resourceSlackConversationDelete(ctx context.Context, d *schema.ResourceData
 	case conversationActionOnDestroyArchive:
 		logger.debug(ctx, "Archive the conversation (%s) on destroy", d.Get("name").(string))
 
+		if diags := ensureSlackConversationMembership(ctx, logger, d, meta); diags.HasError() {
+			return diags
+		}
+
 		if err := client.ArchiveConversationContext(ctx, id); err != nil {
 			if err.Error() != "already_archived" {
 				return diag.Diagnostics{
@@ -308,3 +516,113 @@ func resourceSlackConversationDelete(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+// ensureSlackConversationMembership joins the conversation because conversations.* write methods require the membership
+func ensureSlackConversationMembership(ctx context.Context, logger *Logger, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("ensure_bot_membership").(bool) || d.Get("is_member").(bool) {
+		return nil
+	}
+
+	id := d.Id()
+
+	if d.Get("is_private").(bool) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't join a private slack conversation (%s)", id),
+				Detail:   "The token owner cannot join private conversations by itself. Please invite it to the conversation in advance.",
+			},
+		}
+	}
+
+	if _, _, _, err := meta.(*Team).client.JoinConversationContext(ctx, id); err != nil {
+		if err.Error() != "is_archived" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't join a slack conversation (%s) due to *%s*", id, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.join"),
+				},
+			}
+		} else {
+			logger.debug(ctx, "Archived conversations cannot be joined")
+		}
+	} else {
+		logger.trace(ctx, "Joined the conversation")
+	}
+
+	return nil
+}
+
+func customizeDiffSlackConversation(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" && (d.Get("org_wide").(bool) || d.Get("team_id").(string) != "") {
+		if meta.(*Team).adminApi == nil {
+			return fmt.Errorf("org_wide and team_id require admin_token of the provider because admin.conversations.create accepts only a token of an org admin or owner")
+		}
+	}
+
+	if restrictions, ok := d.GetOk("posting_restrictions"); ok && len(restrictions.([]interface{})) > 0 {
+		if meta.(*Team).adminApi == nil {
+			return fmt.Errorf("posting_restrictions requires admin_token of the provider because admin.conversations.setConversationPrefs accepts only a token of an org admin or owner")
+		}
+	}
+
+	return nil
+}
+
+func updateSlackConversationPostingRestrictions(ctx context.Context, logger *Logger, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := d.Id()
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't set posting restrictions of a slack conversation (%s) without an admin token", id))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := adminApi.setConversationPrefs(ctx, id, expandConversationPrefs(d.Get("posting_restrictions").([]interface{}))); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't set posting restrictions of a slack conversation (%s) due to *%s*", id, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.setConversationPrefs"),
+			},
+		}
+	}
+
+	logger.trace(ctx, "Set posting restrictions of the conversation")
+
+	return nil
+}
+
+// expandConversationPrefs returns empty prefs to remove all restrictions if the block is removed
+func expandConversationPrefs(restrictions []interface{}) conversationPrefs {
+	if len(restrictions) == 0 || restrictions[0] == nil {
+		return conversationPrefs{}
+	}
+
+	restriction := restrictions[0].(map[string]interface{})
+
+	return conversationPrefs{
+		WhoCanPost: conversationPrefEntities{
+			Types:      schemaSetToStrings(restriction["who_can_post_types"].(*schema.Set)),
+			Users:      schemaSetToStrings(restriction["who_can_post_users"].(*schema.Set)),
+			UserGroups: schemaSetToStrings(restriction["who_can_post_usergroups"].(*schema.Set)),
+		},
+		CanThread: conversationPrefEntities{
+			Types: schemaSetToStrings(restriction["can_thread_types"].(*schema.Set)),
+			Users: schemaSetToStrings(restriction["can_thread_users"].(*schema.Set)),
+		},
+	}
+}
+
+func flattenConversationPrefs(prefs *conversationPrefs) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"who_can_post_types":      prefs.WhoCanPost.Types,
+			"who_can_post_users":      prefs.WhoCanPost.Users,
+			"who_can_post_usergroups": prefs.WhoCanPost.UserGroups,
+			"can_thread_types":        prefs.CanThread.Types,
+			"can_thread_users":        prefs.CanThread.Users,
+		},
+	}
+}
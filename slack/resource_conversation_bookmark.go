@@ -0,0 +1,234 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"time"
+)
+
+const bookmarkTypeLink = "link"
+
+func resourceSlackConversationBookmark() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationBookmarkRead,
+		CreateContext: resourceSlackConversationBookmarkCreate,
+		UpdateContext: resourceSlackConversationBookmarkUpdate,
+		DeleteContext: resourceSlackConversationBookmarkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"emoji": {
+				Type:        schema.TypeString,
+				Description: "An emoji tag like :book:",
+				Optional:    true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Description:      "Only link is supported",
+				Optional:         true,
+				ForceNew:         true,
+				Default:          bookmarkTypeLink,
+				ValidateDiagFunc: validateEnums([]string{bookmarkTypeLink}),
+			},
+			"bookmark_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func configureSlackConversationBookmark(ctx context.Context, logger *Logger, d *schema.ResourceData, bookmark slack.Bookmark) {
+	d.SetId(buildCompositeId(bookmark.ChannelID, bookmark.ID))
+	_ = d.Set("channel_id", bookmark.ChannelID)
+	_ = d.Set("bookmark_id", bookmark.ID)
+	_ = d.Set("title", bookmark.Title)
+	_ = d.Set("link", bookmark.Link)
+	_ = d.Set("emoji", bookmark.Emoji)
+	_ = d.Set("type", bookmark.Type)
+
+	logger.debug(ctx, "Configured the bookmark %s", bookmark.ID)
+}
+
+func resourceSlackConversationBookmarkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_bookmark",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start adding a bookmark to the conversation")
+
+	bookmark, err := client.AddBookmarkContext(ctx, channelId, slack.AddBookmarkParameters{
+		Title: d.Get("title").(string),
+		Type:  d.Get("type").(string),
+		Link:  d.Get("link").(string),
+		Emoji: d.Get("emoji").(string),
+	})
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't add a bookmark to the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/bookmarks.add"),
+			},
+		}
+	} else {
+		logger.trace(ctx, "Got a response from Slack API")
+	}
+
+	configureSlackConversationBookmark(ctx, logger, d, bookmark)
+
+	return nil
+}
+
+func resourceSlackConversationBookmarkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := d.Id()
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource": "slack_conversation_bookmark",
+		"id":       id,
+	})
+
+	logger.trace(ctx, "Start reading the bookmark")
+
+	channelId, bookmarkId, err := splitCompositeId(id)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bookmarks, err := client.ListBookmarksContext(ctx, channelId)
+
+	if err != nil {
+		if err.Error() == "channel_not_found" || err.Error() == "is_archived" {
+			logger.debug(ctx, "The conversation has been removed or archived so remove this resource from the state")
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read bookmarks of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/bookmarks.list"),
+			},
+		}
+	}
+
+	for _, bookmark := range bookmarks {
+		if bookmark.ID == bookmarkId {
+			configureSlackConversationBookmark(ctx, logger, d, bookmark)
+			return nil
+		}
+	}
+
+	logger.debug(ctx, "The bookmark has been removed so remove this resource from the state")
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSlackConversationBookmarkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	bookmarkId := d.Get("bookmark_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_bookmark",
+		"conversation_id": channelId,
+		"bookmark_id":     bookmarkId,
+	})
+
+	logger.trace(ctx, "Start editing the bookmark")
+
+	title := d.Get("title").(string)
+	emoji := d.Get("emoji").(string)
+
+	bookmark, err := client.EditBookmarkContext(ctx, channelId, bookmarkId, slack.EditBookmarkParameters{
+		Title: &title,
+		Emoji: &emoji,
+		Link:  d.Get("link").(string),
+	})
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't edit the bookmark (%s) of the slack conversation (%s) due to *%s*", bookmarkId, channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/bookmarks.edit"),
+			},
+		}
+	} else {
+		logger.trace(ctx, "Got a response from Slack API")
+	}
+
+	configureSlackConversationBookmark(ctx, logger, d, bookmark)
+
+	return nil
+}
+
+func resourceSlackConversationBookmarkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	bookmarkId := d.Get("bookmark_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_bookmark",
+		"conversation_id": channelId,
+		"bookmark_id":     bookmarkId,
+	})
+
+	logger.trace(ctx, "Start removing the bookmark")
+
+	if err := client.RemoveBookmarkContext(ctx, channelId, bookmarkId); err != nil {
+		if err.Error() != "not_found" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't remove the bookmark (%s) from the slack conversation (%s) due to *%s*", bookmarkId, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/bookmarks.remove"),
+				},
+			}
+		} else {
+			logger.debug(ctx, "The bookmark has already been removed")
+		}
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this bookmark so it's going to be removed from the state")
+
+	return nil
+}
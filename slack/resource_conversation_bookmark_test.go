@@ -0,0 +1,87 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+type bookmarksListResponse struct {
+	slack.SlackResponse
+	Bookmarks []slack.Bookmark `json:"bookmarks"`
+}
+
+func Test_ResourceConversationBookmarkRead(t *testing.T) {
+	cases := []struct {
+		BookmarkId  string
+		ExpectId    string
+		ExpectTitle string
+	}{
+		{
+			BookmarkId:  "Bk0001",
+			ExpectId:    "C0001:Bk0001",
+			ExpectTitle: "Runbook (edited)",
+		},
+		{
+			BookmarkId: "Bk0002",
+			ExpectId:   "",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationBookmark().TestResourceData()
+		d.SetId(buildCompositeId("C0001", tc.BookmarkId))
+		_ = d.Set("title", "Runbook")
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/bookmarks.list",
+				Response: bookmarksListResponse{
+					slack.SlackResponse{Ok: true},
+					[]slack.Bookmark{
+						{
+							ID:        "Bk0001",
+							ChannelID: "C0001",
+							Title:     "Runbook (edited)",
+							Link:      "https://example.com/runbook",
+							Type:      bookmarkTypeLink,
+						},
+					},
+				},
+			},
+		})
+
+		if diags := resourceSlackConversationBookmarkRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("expect id to be %s, but got %s", tc.ExpectId, d.Id())
+		}
+
+		if title := d.Get("title").(string); tc.ExpectId != "" && title != tc.ExpectTitle {
+			t.Fatalf("expect title to be %s, but got %s", tc.ExpectTitle, title)
+		}
+	}
+}
+
+func Test_ResourceConversationBookmarkReadWithRemovedConversation(t *testing.T) {
+	for _, e := range []string{"channel_not_found", "is_archived"} {
+		d := resourceSlackConversationBookmark().TestResourceData()
+		d.SetId(buildCompositeId("C0001", "Bk0001"))
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path:     "/bookmarks.list",
+				Response: slack.SlackResponse{Ok: false, Error: e},
+			},
+		})
+
+		if diags := resourceSlackConversationBookmarkRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != "" {
+			t.Fatalf("expect id to be empty on %s, but got %s", e, d.Id())
+		}
+	}
+}
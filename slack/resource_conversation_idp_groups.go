@@ -0,0 +1,229 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+	"time"
+)
+
+func resourceSlackConversationIdpGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationIdpGroupsRead,
+		CreateContext: resourceSlackConversationIdpGroupsCreate,
+		UpdateContext: resourceSlackConversationIdpGroupsUpdate,
+		DeleteContext: resourceSlackConversationIdpGroupsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// <channel id>:<team id> for conversations that are not org-wide
+				if strings.Contains(d.Id(), ":") {
+					channelId, teamId, err := splitCompositeId(d.Id())
+
+					if err != nil {
+						return nil, err
+					}
+
+					d.SetId(channelId)
+					_ = d.Set("team_id", teamId)
+				}
+
+				_ = d.Set("channel_id", d.Id())
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_ids": {
+				Type:        schema.TypeSet,
+				Description: "IdP groups whose members can join the private conversation",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Description: "A workspace that the conversation belongs to. Required if the conversation is not org-wide",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationIdpGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_idp_groups",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start linking IdP groups to the conversation")
+
+	if diags := updateSlackConversationIdpGroups(ctx, logger, d, meta, channelId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(channelId)
+
+	return resourceSlackConversationIdpGroupsRead(ctx, d, meta)
+}
+
+func resourceSlackConversationIdpGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_idp_groups",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start reading IdP groups of the conversation")
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't read IdP groups of the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	groupIds, err := adminApi.listRestrictAccessGroups(ctx, channelId, d.Get("team_id").(string))
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read IdP groups of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.restrictAccess.listGroups"),
+			},
+		}
+	}
+
+	_ = d.Set("channel_id", channelId)
+	_ = d.Set("group_ids", groupIds)
+
+	logger.debug(ctx, "Configured %d IdP groups of the conversation", len(groupIds))
+
+	return nil
+}
+
+func resourceSlackConversationIdpGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_idp_groups",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start updating IdP groups of the conversation")
+
+	if diags := updateSlackConversationIdpGroups(ctx, logger, d, meta, channelId); diags.HasError() {
+		return diags
+	}
+
+	return resourceSlackConversationIdpGroupsRead(ctx, d, meta)
+}
+
+func resourceSlackConversationIdpGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+	teamId := d.Get("team_id").(string)
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_idp_groups",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start unlinking IdP groups from the conversation")
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't unlink IdP groups from the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, groupId := range schemaSetToStrings(d.Get("group_ids").(*schema.Set)) {
+		if err := adminApi.removeRestrictAccessGroup(ctx, channelId, groupId, teamId); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't unlink an IdP group (%s) from the slack conversation (%s) due to *%s*", groupId, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.restrictAccess.removeGroup"),
+				},
+			}
+		}
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this conversation IdP groups' resource so it's going to be removed from the state")
+
+	return nil
+}
+
+func updateSlackConversationIdpGroups(ctx context.Context, logger *Logger, d *schema.ResourceData, meta interface{}, channelId string) diag.Diagnostics {
+	teamId := d.Get("team_id").(string)
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't link IdP groups to the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := adminApi.listRestrictAccessGroups(ctx, channelId, teamId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read IdP groups of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.restrictAccess.listGroups"),
+			},
+		}
+	}
+
+	desired := schemaSetToStrings(d.Get("group_ids").(*schema.Set))
+
+	for _, groupId := range subtractStrings(desired, current) {
+		if err := adminApi.addRestrictAccessGroup(ctx, channelId, groupId, teamId); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't link an IdP group (%s) to the slack conversation (%s) due to *%s*", groupId, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.restrictAccess.addGroup"),
+				},
+			}
+		}
+
+		logger.trace(ctx, "Linked an IdP group (%s)", groupId)
+	}
+
+	for _, groupId := range subtractStrings(current, desired) {
+		if err := adminApi.removeRestrictAccessGroup(ctx, channelId, groupId, teamId); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't unlink an IdP group (%s) from the slack conversation (%s) due to *%s*", groupId, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.restrictAccess.removeGroup"),
+				},
+			}
+		}
+
+		logger.trace(ctx, "Unlinked an IdP group (%s)", groupId)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package slack
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"net/http"
+	"testing"
+)
+
+func Test_ResourceConversationIdpGroupsCreate(t *testing.T) {
+	d := resourceSlackConversationIdpGroups().TestResourceData()
+	if err := d.Set("channel_id", "C0001"); err != nil {
+		t.Fatalf("err set channel_id: %s", err)
+	}
+	if err := d.Set("group_ids", []string{"G0001", "G0002"}); err != nil {
+		t.Fatalf("err set group_ids: %s", err)
+	}
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/admin.conversations.restrictAccess.listGroups",
+			Response: restrictAccessGroupsResponse{
+				slack.SlackResponse{Ok: true},
+				[]string{"G0001", "G0002"},
+			},
+		},
+		{
+			Path:     "/admin.conversations.restrictAccess.addGroup",
+			Response: slack.SlackResponse{Ok: true},
+		},
+		{
+			Path:     "/admin.conversations.restrictAccess.removeGroup",
+			Response: slack.SlackResponse{Ok: true},
+		},
+	})
+
+	team.adminApi = team.api
+
+	if diags := resourceSlackConversationIdpGroupsCreate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if d.Id() != "C0001" {
+		t.Fatalf("expect id to be C0001, but got %s", d.Id())
+	}
+}
+
+func Test_ResourceConversationIdpGroupsUpdate(t *testing.T) {
+	d := resourceSlackConversationIdpGroups().TestResourceData()
+	d.SetId("C0001")
+	if err := d.Set("group_ids", []string{"G0002", "G0003"}); err != nil {
+		t.Fatalf("err set group_ids: %s", err)
+	}
+
+	groupIds := []string{"G0001", "G0002"}
+	var added []string
+	var removed []string
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/admin.conversations.restrictAccess.listGroups",
+			Response: func(r *http.Request) interface{} {
+				return restrictAccessGroupsResponse{slack.SlackResponse{Ok: true}, groupIds}
+			},
+		},
+		{
+			Path: "/admin.conversations.restrictAccess.addGroup",
+			Response: func(r *http.Request) interface{} {
+				added = append(added, r.FormValue("group_id"))
+				groupIds = append(groupIds, r.FormValue("group_id"))
+				return slack.SlackResponse{Ok: true}
+			},
+		},
+		{
+			Path: "/admin.conversations.restrictAccess.removeGroup",
+			Response: func(r *http.Request) interface{} {
+				removed = append(removed, r.FormValue("group_id"))
+				groupIds = subtractStrings(groupIds, []string{r.FormValue("group_id")})
+				return slack.SlackResponse{Ok: true}
+			},
+		},
+	})
+
+	team.adminApi = team.api
+
+	if diags := resourceSlackConversationIdpGroupsUpdate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if len(added) != 1 || added[0] != "G0003" {
+		t.Fatalf("expect only G0003 to be linked, but got %v", added)
+	}
+
+	if len(removed) != 1 || removed[0] != "G0001" {
+		t.Fatalf("expect only G0001 to be unlinked, but got %v", removed)
+	}
+
+	if groups := d.Get("group_ids").(*schema.Set); groups.Len() != 2 || !groups.Contains("G0002") || !groups.Contains("G0003") {
+		t.Fatalf("expect G0002 and G0003, but got %v", groups.List())
+	}
+}
+
+func Test_ResourceConversationIdpGroupsImport(t *testing.T) {
+	cases := []struct {
+		Id           string
+		ExpectTeamId string
+	}{
+		{
+			Id:           "C0001",
+			ExpectTeamId: "",
+		},
+		{
+			Id:           "C0001:T0001",
+			ExpectTeamId: "T0001",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationIdpGroups().TestResourceData()
+		d.SetId(tc.Id)
+
+		if _, err := resourceSlackConversationIdpGroups().Importer.StateContext(context.Background(), d, nil); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if d.Id() != "C0001" || d.Get("channel_id").(string) != "C0001" {
+			t.Fatalf("expect id to be C0001, but got %s", d.Id())
+		}
+
+		if teamId := d.Get("team_id").(string); teamId != tc.ExpectTeamId {
+			t.Fatalf("expect team_id to be %s, but got %s", tc.ExpectTeamId, teamId)
+		}
+	}
+}
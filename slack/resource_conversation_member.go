@@ -0,0 +1,211 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+const (
+	conversationMemberActionOnDestroyKick = "kick"
+	conversationMemberActionOnDestroyNone = "none"
+)
+
+var validateConversationMemberActionOnDestroyValue = validation.StringInSlice([]string{
+	conversationMemberActionOnDestroyKick,
+	conversationMemberActionOnDestroyNone,
+}, false)
+
+func resourceSlackConversationMember() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationMemberRead,
+		CreateContext: resourceSlackConversationMemberCreate,
+		UpdateContext: resourceSlackConversationMemberUpdate,
+		DeleteContext: resourceSlackConversationMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				channelId, userId, err := splitCompositeId(d.Id())
+
+				if err != nil {
+					return nil, err
+				}
+
+				_ = d.Set("channel_id", channelId)
+				_ = d.Set("user_id", userId)
+				_ = d.Set("action_on_destroy", conversationMemberActionOnDestroyKick)
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action_on_destroy": {
+				Type:         schema.TypeString,
+				Description:  "Either of kick or none",
+				Optional:     true,
+				Default:      conversationMemberActionOnDestroyKick,
+				ValidateFunc: validateConversationMemberActionOnDestroyValue,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	userId := d.Get("user_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_member",
+		"conversation_id": channelId,
+		"user_id":         userId,
+	})
+
+	logger.trace(ctx, "Start inviting the user to the conversation")
+
+	if _, err := client.InviteUsersToConversationContext(ctx, channelId, userId); err != nil {
+		if err.Error() != "already_in_channel" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't invite %s to the slack conversation (%s) due to *%s*", userId, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.invite"),
+				},
+			}
+		} else {
+			logger.debug(ctx, "The user has already been in the conversation")
+		}
+	} else {
+		logger.trace(ctx, "Got a response from Slack API")
+	}
+
+	d.SetId(buildCompositeId(channelId, userId))
+
+	return resourceSlackConversationMemberRead(ctx, d, meta)
+}
+
+func resourceSlackConversationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := d.Id()
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource": "slack_conversation_member",
+		"id":       id,
+	})
+
+	logger.trace(ctx, "Start reading the conversation member")
+
+	channelId, userId, err := splitCompositeId(id)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members, err := getConversationMembers(ctx, client, channelId)
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			logger.debug(ctx, "The conversation has gone so remove this resource from the state")
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read members of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.members"),
+			},
+		}
+	}
+
+	if !containsAny(members, userId) {
+		logger.debug(ctx, "The user has left the conversation so remove this resource from the state")
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("channel_id", channelId)
+	_ = d.Set("user_id", userId)
+
+	logger.debug(ctx, "Configured the conversation member")
+
+	return nil
+}
+
+func resourceSlackConversationMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only action_on_destroy is updatable and it's used only in Delete
+	return resourceSlackConversationMemberRead(ctx, d, meta)
+}
+
+func resourceSlackConversationMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	userId := d.Get("user_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_member",
+		"conversation_id": channelId,
+		"user_id":         userId,
+	})
+
+	action := d.Get("action_on_destroy").(string)
+
+	switch action {
+	case conversationMemberActionOnDestroyNone:
+		logger.debug(ctx, "Does nothing on destroy")
+	case conversationMemberActionOnDestroyKick:
+		logger.debug(ctx, "Kick the user from the conversation on destroy")
+
+		if err := client.KickUserFromConversationContext(ctx, channelId, userId); err != nil {
+			switch err.Error() {
+			case "not_in_channel", "channel_not_found":
+				logger.debug(ctx, "The user has already left the conversation")
+			default:
+				return diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Slack provider couldn't kick %s from the slack conversation (%s) due to *%s*", userId, channelId, err.Error()),
+						Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.kick"),
+					},
+				}
+			}
+		}
+
+		logger.trace(ctx, "Kicked the user from the conversation")
+	default:
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s in action_on_destroy is not acceptable", action),
+				Detail:   fmt.Sprintf("Either one of %s and %s is allowed", conversationMemberActionOnDestroyKick, conversationMemberActionOnDestroyNone),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this conversation member so it's going to be removed from the state")
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+func Test_ResourceConversationMemberRead(t *testing.T) {
+	cases := []struct {
+		UserId   string
+		ExpectId string
+	}{
+		{
+			UserId:   "U0614TZR7",
+			ExpectId: "C012AB3CD:U0614TZR7",
+		},
+		{
+			UserId:   "ULEFT",
+			ExpectId: "",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationMember().TestResourceData()
+		d.SetId(buildCompositeId("C012AB3CD", tc.UserId))
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.members",
+				Response: conversationMembersResponse{
+					slack.SlackResponse{Ok: true},
+					testConversationMembers,
+				},
+			},
+		})
+
+		if diags := resourceSlackConversationMemberRead(ctx, d, team); diags.HasError() {
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					t.Fatalf("err: %s", d.Summary)
+				}
+			}
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("expect id to be %s, but got %s", tc.ExpectId, d.Id())
+		}
+	}
+}
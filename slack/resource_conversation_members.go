@@ -0,0 +1,423 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/slack-go/slack"
+	"strings"
+	"time"
+)
+
+const (
+	conversationMembersModeAuthoritative = "authoritative"
+	conversationMembersModeAdditive      = "additive"
+
+	// conversations.invite accepts up to 1000 user ids at once
+	conversationInviteChunkSize = 1000
+)
+
+var validateConversationMembersModeValue = validation.StringInSlice([]string{
+	conversationMembersModeAuthoritative,
+	conversationMembersModeAdditive,
+}, false)
+
+func resourceSlackConversationMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationMembersRead,
+		CreateContext: resourceSlackConversationMembersCreate,
+		UpdateContext: resourceSlackConversationMembersUpdate,
+		DeleteContext: resourceSlackConversationMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("channel_id", d.Id())
+				_ = d.Set("mode", conversationMembersModeAuthoritative)
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"members": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Description:  "Either of authoritative or additive. additive never removes members that this resource didn't add. authoritative never kicks the owner of the token",
+				Optional:     true,
+				Default:      conversationMembersModeAuthoritative,
+				ValidateFunc: validateConversationMembersModeValue,
+			},
+			"added_members": {
+				Type:        schema.TypeSet,
+				Description: "Members that this resource invited. additive mode kicks only them",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSlackConversationMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_members",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start creating members of the conversation")
+
+	current, err := getConversationMembers(ctx, client, channelId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read members of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.members"),
+			},
+		}
+	}
+
+	desired := schemaSetToStrings(d.Get("members").(*schema.Set))
+
+	var toRemove []string
+
+	if d.Get("mode").(string) == conversationMembersModeAuthoritative {
+		ownerId, diags := getConversationMembersTokenOwnerId(ctx, meta.(*Team))
+
+		if diags.HasError() {
+			return diags
+		}
+
+		toRemove = subtractStrings(current, append(desired, ownerId))
+	}
+
+	toInvite := subtractStrings(desired, current)
+
+	if diags := applyConversationMembers(ctx, logger, client, channelId, toInvite, toRemove, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(channelId)
+	_ = d.Set("added_members", toInvite)
+
+	return resourceSlackConversationMembersRead(ctx, d, meta)
+}
+
+func resourceSlackConversationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	currentId := d.Id()
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_members",
+		"conversation_id": currentId,
+	})
+
+	logger.trace(ctx, "Start reading the conversation members")
+
+	members, err := getConversationMembers(ctx, client, currentId)
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			logger.debug(ctx, "The conversation has gone so remove this resource from the state")
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read members of the slack conversation (%s) due to *%s*", currentId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.members"),
+			},
+		}
+	}
+
+	users, err := getUsersWithCache(ctx, client)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read users to check deactivated members due to *%s*", err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.list"),
+			},
+		}
+	}
+
+	configured := schemaSetToStrings(d.Get("members").(*schema.Set))
+	added := intersectStrings(schemaSetToStrings(d.Get("added_members").(*schema.Set)), members)
+
+	// Deactivated users cannot be kicked nor invited so they are out of management
+	members = subtractStrings(members, findDeactivatedUsers(users, members))
+
+	if d.Get("mode").(string) == conversationMembersModeAdditive {
+		// Never report members that this resource doesn't know
+		members = intersectStrings(members, configured)
+	} else {
+		ownerId, diags := getConversationMembersTokenOwnerId(ctx, meta.(*Team))
+
+		if diags.HasError() {
+			return diags
+		}
+
+		// The token owner cannot kick itself so it's reported only if it's configured
+		if !containsAny(configured, ownerId) {
+			members = subtractStrings(members, []string{ownerId})
+		}
+	}
+
+	// Keep configured deactivated users so they don't appear as a diff forever
+	for _, userId := range findDeactivatedUsers(users, configured) {
+		if !containsAny(members, userId) {
+			members = append(members, userId)
+		}
+	}
+
+	_ = d.Set("channel_id", currentId)
+	_ = d.Set("members", members)
+	_ = d.Set("added_members", added)
+
+	logger.debug(ctx, "Configured members of the conversation")
+
+	return nil
+}
+
+func resourceSlackConversationMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_members",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start updating members of the conversation")
+
+	current, err := getConversationMembers(ctx, client, channelId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read members of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.members"),
+			},
+		}
+	}
+
+	o, n := d.GetChange("members")
+	desired := schemaSetToStrings(n.(*schema.Set))
+	added := schemaSetToStrings(d.Get("added_members").(*schema.Set))
+
+	var toRemove []string
+
+	if d.Get("mode").(string) == conversationMembersModeAuthoritative {
+		ownerId, diags := getConversationMembersTokenOwnerId(ctx, meta.(*Team))
+
+		if diags.HasError() {
+			return diags
+		}
+
+		toRemove = subtractStrings(current, append(desired, ownerId))
+	} else {
+		// Remove only the members that this resource invited before
+		toRemove = intersectStrings(subtractStrings(added, desired), current)
+	}
+
+	toInvite := subtractStrings(desired, current)
+
+	// Deactivated users in the state have been kept by Read so skip them instead of failing
+	if diags := applyConversationMembers(ctx, logger, client, channelId, toInvite, toRemove, schemaSetToStrings(o.(*schema.Set))); diags.HasError() {
+		return diags
+	}
+
+	_ = d.Set("added_members", append(subtractStrings(added, toRemove), subtractStrings(toInvite, added)...))
+
+	return resourceSlackConversationMembersRead(ctx, d, meta)
+}
+
+func resourceSlackConversationMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_members",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start destroying members of the conversation")
+
+	members := schemaSetToStrings(d.Get("members").(*schema.Set))
+
+	if d.Get("mode").(string) == conversationMembersModeAdditive {
+		// Keep members who had been in the conversation before this resource invited them
+		members = schemaSetToStrings(d.Get("added_members").(*schema.Set))
+	}
+
+	if diags := applyConversationMembers(ctx, logger, client, channelId, nil, members, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this conversation members' resource so it's going to be removed from the state")
+
+	return nil
+}
+
+// applyConversationMembers skips deactivated users in known instead of failing because Read has kept them in the state
+func applyConversationMembers(ctx context.Context, logger *Logger, client *slack.Client, channelId string, toInvite []string, toKick []string, known []string) diag.Diagnostics {
+	if len(toInvite) > 0 || len(toKick) > 0 {
+		users, err := getUsersWithCache(ctx, client)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't read users to check deactivated members due to *%s*", err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.list"),
+				},
+			}
+		}
+
+		deactivated := findDeactivatedUsers(users, toInvite)
+
+		if kept := intersectStrings(deactivated, known); len(kept) > 0 {
+			logger.debug(ctx, "Skip inviting deactivated users %v", kept)
+			toInvite = subtractStrings(toInvite, kept)
+			deactivated = subtractStrings(deactivated, kept)
+		}
+
+		if len(deactivated) > 0 {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Deactivated users cannot be invited to the slack conversation (%s)", channelId),
+					Detail:   fmt.Sprintf("Please remove %s from members", strings.Join(deactivated, ", ")),
+				},
+			}
+		}
+
+		// Deactivated users cannot be kicked either
+		toKick = subtractStrings(toKick, findDeactivatedUsers(users, toKick))
+	}
+
+	for _, chunk := range chunkStrings(toInvite, conversationInviteChunkSize) {
+		logger.debug(ctx, "Invite %d users to the conversation", len(chunk))
+
+		if _, err := client.InviteUsersToConversationContext(ctx, channelId, chunk...); err != nil {
+			if err.Error() != "already_in_channel" {
+				return diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Slack provider couldn't invite users to the slack conversation (%s) due to *%s*", channelId, err.Error()),
+						Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.invite"),
+					},
+				}
+			} else {
+				logger.debug(ctx, "Some users have already been in the conversation")
+			}
+		}
+	}
+
+	for _, userId := range toKick {
+		if err := client.KickUserFromConversationContext(ctx, channelId, userId); err != nil {
+			switch err.Error() {
+			case "cant_kick_self":
+				logger.debug(ctx, "The token owner (%s) cannot kick itself so keep it", userId)
+			case "not_in_channel":
+				logger.debug(ctx, "%s has already left the conversation", userId)
+			default:
+				return diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Slack provider couldn't kick %s from the slack conversation (%s) due to *%s*", userId, channelId, err.Error()),
+						Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.kick"),
+					},
+				}
+			}
+		} else {
+			logger.trace(ctx, "Kicked %s from the conversation", userId)
+		}
+	}
+
+	return nil
+}
+
+// getConversationMembersTokenOwnerId returns the token owner that authoritative mode leaves in conversations because it cannot kick itself
+func getConversationMembersTokenOwnerId(ctx context.Context, team *Team) (string, diag.Diagnostics) {
+	ownerId, err := team.getTokenOwnerId(ctx)
+
+	if err != nil {
+		return "", diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't identify the owner of the token due to *%s*", err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/auth.test"),
+			},
+		}
+	}
+
+	return ownerId, nil
+}
+
+func getConversationMembers(ctx context.Context, client *slack.Client, channelId string) ([]string, error) {
+	var members []string
+
+	params := &slack.GetUsersInConversationParameters{
+		ChannelID: channelId,
+		Limit:     1000,
+	}
+
+	for {
+		page, nextCursor, err := client.GetUsersInConversationContext(ctx, params)
+
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, page...)
+
+		if nextCursor == "" {
+			return members, nil
+		}
+
+		params.Cursor = nextCursor
+	}
+}
+
+func findDeactivatedUsers(users []slack.User, userIds []string) []string {
+	var deactivated []string
+
+	for _, user := range users {
+		if user.Deleted && containsAny(userIds, user.ID) {
+			deactivated = append(deactivated, user.ID)
+		}
+	}
+
+	return deactivated
+}
@@ -0,0 +1,344 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type conversationMembersResponse struct {
+	slack.SlackResponse
+	Members []string `json:"members"`
+}
+
+type usersListResponse struct {
+	slack.SlackResponse
+	Members []slack.User `json:"members"`
+}
+
+type authTestResponse struct {
+	slack.SlackResponse
+	slack.AuthTestResponse
+}
+
+var testConversationMembers = []string{"U0614TZR7", "U060RNRCZ", "UDEACTIVE"}
+
+var testUsers = []slack.User{
+	{ID: "U0614TZR7"},
+	{ID: "U060RNRCZ"},
+	{ID: "UDEACTIVE", Deleted: true},
+}
+
+// testTokenOwnerRoute tells UBOT is the owner of the token
+var testTokenOwnerRoute = Route{
+	Path: "/auth.test",
+	Response: authTestResponse{
+		slack.SlackResponse{Ok: true},
+		slack.AuthTestResponse{UserID: "UBOT"},
+	},
+}
+
+func Test_ResourceConversationMembersRead(t *testing.T) {
+	cases := []struct {
+		Mode          string
+		ManagedUsers  []string
+		ExpectMembers []string
+	}{
+		{
+			Mode:          conversationMembersModeAuthoritative,
+			ManagedUsers:  []string{"U0614TZR7"},
+			ExpectMembers: []string{"U0614TZR7", "U060RNRCZ"},
+		},
+		{
+			Mode:          conversationMembersModeAdditive,
+			ManagedUsers:  []string{"U0614TZR7"},
+			ExpectMembers: []string{"U0614TZR7"},
+		},
+		{
+			Mode:          conversationMembersModeAuthoritative,
+			ManagedUsers:  []string{"U0614TZR7", "UDEACTIVE"},
+			ExpectMembers: []string{"U0614TZR7", "U060RNRCZ", "UDEACTIVE"},
+		},
+		{
+			Mode:          conversationMembersModeAdditive,
+			ManagedUsers:  []string{"U0614TZR7", "UDEACTIVE"},
+			ExpectMembers: []string{"U0614TZR7", "UDEACTIVE"},
+		},
+		{
+			Mode:          conversationMembersModeAuthoritative,
+			ManagedUsers:  []string{"U0614TZR7", "UBOT"},
+			ExpectMembers: []string{"U0614TZR7", "U060RNRCZ", "UBOT"},
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationMembers().TestResourceData()
+		d.SetId("C012AB3CD")
+		if err := d.Set("mode", tc.Mode); err != nil {
+			t.Fatalf("err set mode: %s", err)
+		}
+		if err := d.Set("members", tc.ManagedUsers); err != nil {
+			t.Fatalf("err set members: %s", err)
+		}
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.members",
+				Response: conversationMembersResponse{
+					slack.SlackResponse{Ok: true},
+					append([]string{"UBOT"}, testConversationMembers...),
+				},
+			},
+			{
+				Path: "/users.list",
+				Response: usersListResponse{
+					slack.SlackResponse{Ok: true},
+					testUsers,
+				},
+			},
+			testTokenOwnerRoute,
+		})
+
+		if diags := resourceSlackConversationMembersRead(ctx, d, team); diags.HasError() {
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					t.Fatalf("err: %s", d.Summary)
+				}
+			}
+		}
+
+		members := d.Get("members").(*schema.Set)
+		if len(tc.ExpectMembers) != members.Len() {
+			t.Fatalf("expect %v members but got %v in %s mode", len(tc.ExpectMembers), members.Len(), tc.Mode)
+		}
+		for _, m := range members.List() {
+			if !stringInSlice(tc.ExpectMembers, m.(string)) {
+				t.Fatalf("unexpected user ID %s in %s mode", m, tc.Mode)
+			}
+		}
+	}
+}
+
+func Test_ResourceConversationMembersRefreshInAuthoritativeMode(t *testing.T) {
+	raw := map[string]interface{}{
+		"channel_id": "C012AB3CD",
+		"members":    []interface{}{"U0614TZR7", "U060RNRCZ"},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSlackConversationMembers().Schema, raw)
+	d.SetId("C012AB3CD")
+
+	var kicked []string
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.members",
+			Response: conversationMembersResponse{
+				slack.SlackResponse{Ok: true},
+				[]string{"U0614TZR7", "U060RNRCZ", "UBOT"},
+			},
+		},
+		{
+			Path: "/conversations.kick",
+			Response: func(r *http.Request) interface{} {
+				kicked = append(kicked, r.FormValue("user"))
+				return slack.SlackResponse{Ok: false, Error: "cant_kick_self"}
+			},
+		},
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUsers,
+			},
+		},
+		testTokenOwnerRoute,
+	})
+
+	if diags := resourceSlackConversationMembersRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	diff, err := resourceSlackConversationMembers().Diff(ctx, d.State(), terraform.NewResourceConfigRaw(raw), team)
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !diff.Empty() {
+		t.Fatalf("expect no diff, but got %v", diff.Attributes)
+	}
+
+	if diags := resourceSlackConversationMembersCreate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if len(kicked) > 0 {
+		t.Fatalf("expect nobody to be kicked, but got %v", kicked)
+	}
+}
+
+func Test_ResourceConversationMembersCreateWithDeactivatedUser(t *testing.T) {
+	d := resourceSlackConversationMembers().TestResourceData()
+	if err := d.Set("channel_id", "C012AB3CD"); err != nil {
+		t.Fatalf("err set channel_id: %s", err)
+	}
+	if err := d.Set("members", []string{"U0614TZR7", "UDEACTIVE"}); err != nil {
+		t.Fatalf("err set members: %s", err)
+	}
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.members",
+			Response: conversationMembersResponse{
+				slack.SlackResponse{Ok: true},
+				[]string{},
+			},
+		},
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUsers,
+			},
+		},
+	})
+
+	if diags := resourceSlackConversationMembersCreate(ctx, d, team); !diags.HasError() {
+		t.Fatalf("expect an error for deactivated users")
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expect id to be empty, but got %s", d.Id())
+	}
+}
+
+func Test_ResourceConversationMembersReadWithRemovedConversation(t *testing.T) {
+	d := resourceSlackConversationMembers().TestResourceData()
+	d.SetId("C012AB3CD")
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.members",
+			Response: conversationMembersResponse{
+				slack.SlackResponse{Ok: false, Error: "channel_not_found"},
+				nil,
+			},
+		},
+	})
+
+	if diags := resourceSlackConversationMembersRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expect id to be empty, but got %s", d.Id())
+	}
+}
+
+func Test_ResourceConversationMembersDeleteInAdditiveMode(t *testing.T) {
+	d := resourceSlackConversationMembers().TestResourceData()
+	d.SetId("C012AB3CD")
+	if err := d.Set("mode", conversationMembersModeAdditive); err != nil {
+		t.Fatalf("err set mode: %s", err)
+	}
+	if err := d.Set("members", []string{"U0614TZR7", "U060RNRCZ"}); err != nil {
+		t.Fatalf("err set members: %s", err)
+	}
+	// U0614TZR7 had been in the conversation before this resource was created
+	if err := d.Set("added_members", []string{"U060RNRCZ"}); err != nil {
+		t.Fatalf("err set added_members: %s", err)
+	}
+
+	var kicked []string
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.kick",
+			Response: func(r *http.Request) interface{} {
+				kicked = append(kicked, r.FormValue("user"))
+				return slack.SlackResponse{Ok: true}
+			},
+		},
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUsers,
+			},
+		},
+	})
+
+	if diags := resourceSlackConversationMembersDelete(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if len(kicked) != 1 || kicked[0] != "U060RNRCZ" {
+		t.Fatalf("expect only U060RNRCZ to be kicked, but got %v", kicked)
+	}
+}
+
+func Test_ResourceConversationMembersReadWithTimeout(t *testing.T) {
+	cases := []struct {
+		Timeout     time.Duration
+		ExpectError bool
+	}{
+		{
+			Timeout:     10 * time.Millisecond,
+			ExpectError: true,
+		},
+		{
+			Timeout:     time.Minute,
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		state := &terraform.InstanceState{
+			ID: "C012AB3CD",
+			Attributes: map[string]string{
+				"channel_id": "C012AB3CD",
+				"mode":       conversationMembersModeAuthoritative,
+			},
+		}
+
+		timeouts := &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(tc.Timeout),
+		}
+
+		if err := timeouts.StateEncode(state); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.members",
+				Response: func(r *http.Request) interface{} {
+					time.Sleep(100 * time.Millisecond)
+
+					return conversationMembersResponse{
+						slack.SlackResponse{Ok: true},
+						testConversationMembers,
+					}
+				},
+			},
+			{
+				Path: "/users.list",
+				Response: usersListResponse{
+					slack.SlackResponse{Ok: true},
+					testUsers,
+				},
+			},
+			testTokenOwnerRoute,
+		})
+
+		// The configured timeout reaches the context of Read
+		if _, diags := resourceSlackConversationMembers().RefreshWithoutUpgrade(ctx, state, team); diags.HasError() != tc.ExpectError {
+			t.Fatalf("expect an error to be %t with the timeout %s, but got %v", tc.ExpectError, tc.Timeout, diags)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"time"
+)
+
+func resourceSlackConversationPin() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationPinRead,
+		CreateContext: resourceSlackConversationPinCreate,
+		DeleteContext: resourceSlackConversationPinDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				channelId, timestamp, err := splitCompositeId(d.Id())
+
+				if err != nil {
+					return nil, err
+				}
+
+				_ = d.Set("channel_id", channelId)
+				_ = d.Set("timestamp", timestamp)
+
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"timestamp": {
+				Type:        schema.TypeString,
+				Description: "A timestamp of the message to pin",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationPinCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	timestamp := d.Get("timestamp").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_pin",
+		"conversation_id": channelId,
+		"timestamp":       timestamp,
+	})
+
+	logger.trace(ctx, "Start pinning the message")
+
+	if err := client.AddPinContext(ctx, channelId, slack.NewRefToMessage(channelId, timestamp)); err != nil {
+		if err.Error() != "already_pinned" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't pin the message (%s) to the slack conversation (%s) due to *%s*", timestamp, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/pins.add"),
+				},
+			}
+		} else {
+			logger.debug(ctx, "The message has already been pinned")
+		}
+	} else {
+		logger.trace(ctx, "Got a response from Slack API")
+	}
+
+	d.SetId(buildCompositeId(channelId, timestamp))
+
+	return resourceSlackConversationPinRead(ctx, d, meta)
+}
+
+func resourceSlackConversationPinRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	timestamp := d.Get("timestamp").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_pin",
+		"conversation_id": channelId,
+		"timestamp":       timestamp,
+	})
+
+	logger.trace(ctx, "Start reading pins of the conversation")
+
+	items, _, err := client.ListPinsContext(ctx, channelId)
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			logger.debug(ctx, "The conversation has been removed so remove this resource from the state")
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read pins of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/pins.list"),
+			},
+		}
+	}
+
+	for _, item := range items {
+		if item.Message != nil && item.Message.Timestamp == timestamp {
+			logger.debug(ctx, "The message is still pinned")
+			return nil
+		}
+	}
+
+	logger.debug(ctx, "The message has been unpinned so remove this resource from the state")
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSlackConversationPinDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	timestamp := d.Get("timestamp").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_pin",
+		"conversation_id": channelId,
+		"timestamp":       timestamp,
+	})
+
+	logger.trace(ctx, "Start unpinning the message")
+
+	if err := client.RemovePinContext(ctx, channelId, slack.NewRefToMessage(channelId, timestamp)); err != nil {
+		switch err.Error() {
+		case "no_pin", "message_not_found", "channel_not_found":
+			logger.debug(ctx, "The message has already been unpinned (%s)", err.Error())
+		default:
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't unpin the message (%s) from the slack conversation (%s) due to *%s*", timestamp, channelId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/pins.remove"),
+				},
+			}
+		}
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this pin so it's going to be removed from the state")
+
+	return nil
+}
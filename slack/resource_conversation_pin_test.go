@@ -0,0 +1,54 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+type pinsListResponse struct {
+	slack.SlackResponse
+	Items []slack.Item `json:"items"`
+}
+
+func Test_ResourceConversationPinRead(t *testing.T) {
+	cases := []struct {
+		Timestamp string
+		ExpectId  string
+	}{
+		{
+			Timestamp: "1600000000.000100",
+			ExpectId:  "C0001:1600000000.000100",
+		},
+		{
+			Timestamp: "1600000000.000200",
+			ExpectId:  "",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationPin().TestResourceData()
+		d.SetId(buildCompositeId("C0001", tc.Timestamp))
+		_ = d.Set("channel_id", "C0001")
+		_ = d.Set("timestamp", tc.Timestamp)
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/pins.list",
+				Response: pinsListResponse{
+					slack.SlackResponse{Ok: true},
+					[]slack.Item{
+						slack.NewMessageItem("C0001", &slack.Message{Msg: slack.Msg{Timestamp: "1600000000.000100"}}),
+					},
+				},
+			},
+		})
+
+		if diags := resourceSlackConversationPinRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("expect id to be %s, but got %s", tc.ExpectId, d.Id())
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+func resourceSlackConversationRetention() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationRetentionRead,
+		CreateContext: resourceSlackConversationRetentionCreate,
+		UpdateContext: resourceSlackConversationRetentionUpdate,
+		DeleteContext: resourceSlackConversationRetentionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("channel_id", d.Id())
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"duration_days": {
+				Type:         schema.TypeInt,
+				Description:  "How many days messages and files in the conversation are retained",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceSlackConversationRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_retention",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start creating the custom retention of the conversation")
+
+	if diags := setSlackConversationRetention(ctx, d, meta, channelId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(channelId)
+
+	return resourceSlackConversationRetentionRead(ctx, d, meta)
+}
+
+func resourceSlackConversationRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_retention",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start reading the custom retention of the conversation")
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't read the custom retention of the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	retention, err := adminApi.getCustomRetention(ctx, channelId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read the custom retention of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.getCustomRetention"),
+			},
+		}
+	}
+
+	if !retention.IsPolicyEnabled {
+		logger.debug(ctx, "The custom retention has been removed so remove this resource from the state")
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("channel_id", channelId)
+	_ = d.Set("duration_days", retention.DurationDays)
+
+	logger.debug(ctx, "Configured the custom retention (%d days)", retention.DurationDays)
+
+	return nil
+}
+
+func resourceSlackConversationRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_retention",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start updating the custom retention of the conversation")
+
+	if diags := setSlackConversationRetention(ctx, d, meta, channelId); diags.HasError() {
+		return diags
+	}
+
+	return resourceSlackConversationRetentionRead(ctx, d, meta)
+}
+
+func resourceSlackConversationRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_retention",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start removing the custom retention of the conversation")
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't remove the custom retention of the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := adminApi.removeCustomRetention(ctx, channelId); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't remove the custom retention of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.removeCustomRetention"),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this custom retention so it's going to be removed from the state")
+
+	return nil
+}
+
+func setSlackConversationRetention(ctx context.Context, d *schema.ResourceData, meta interface{}, channelId string) diag.Diagnostics {
+	durationDays := d.Get("duration_days").(int)
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't set the custom retention of the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := adminApi.setCustomRetention(ctx, channelId, durationDays); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't set the custom retention of the slack conversation (%s) to %d days due to *%s*", channelId, durationDays, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.setCustomRetention"),
+			},
+		}
+	}
+
+	return nil
+}
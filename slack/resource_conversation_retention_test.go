@@ -0,0 +1,52 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+func Test_ResourceConversationRetentionRead(t *testing.T) {
+	cases := []struct {
+		IsPolicyEnabled bool
+		ExpectId        string
+	}{
+		{
+			IsPolicyEnabled: true,
+			ExpectId:        "C0001",
+		},
+		{
+			IsPolicyEnabled: false,
+			ExpectId:        "",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationRetention().TestResourceData()
+		d.SetId("C0001")
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/admin.conversations.getCustomRetention",
+				Response: customRetentionResponse{
+					slack.SlackResponse{Ok: true},
+					tc.IsPolicyEnabled,
+					90,
+				},
+			},
+		})
+
+		team.adminApi = team.api
+
+		if diags := resourceSlackConversationRetentionRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("expect id to be %s, but got %s", tc.ExpectId, d.Id())
+		}
+
+		if tc.ExpectId != "" && d.Get("duration_days").(int) != 90 {
+			t.Fatalf("expect 90 days but got %d", d.Get("duration_days").(int))
+		}
+	}
+}
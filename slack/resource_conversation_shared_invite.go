@@ -0,0 +1,216 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+const (
+	connectInviteStatusRevoked  = "revoked"
+	connectInviteStatusDeclined = "declined"
+	connectInviteStatusPending  = "pending"
+	connectInviteStatusAccepted = "accepted"
+	connectInviteStatusApproved = "approved"
+)
+
+func resourceSlackConversationSharedInvite() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationSharedInviteRead,
+		CreateContext: resourceSlackConversationSharedInviteCreate,
+		DeleteContext: resourceSlackConversationSharedInviteDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "Emails to receive the invite",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				AtLeastOneOf: []string{"emails", "user_ids"},
+			},
+			"user_ids": {
+				Type:        schema.TypeSet,
+				Description: "User ids in other workspaces to receive the invite",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				AtLeastOneOf: []string{"emails", "user_ids"},
+			},
+			"external_limited": {
+				Type:        schema.TypeBool,
+				Description: "Whether only admins of the other organization can invite people to the conversation",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"invite_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationSharedInviteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+	emails := schemaSetToStrings(d.Get("emails").(*schema.Set))
+	userIds := schemaSetToStrings(d.Get("user_ids").(*schema.Set))
+	externalLimited := d.Get("external_limited").(bool)
+
+	api := meta.(*Team).api
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_shared_invite",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start sending a shared invite of the conversation")
+
+	inviteId, err := api.inviteShared(ctx, channelId, emails, userIds, externalLimited)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't send a shared invite of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.inviteShared"),
+			},
+		}
+	} else {
+		logger.trace(ctx, "Got a response from Slack API")
+	}
+
+	d.SetId(inviteId)
+	_ = d.Set("invite_id", inviteId)
+
+	return resourceSlackConversationSharedInviteRead(ctx, d, meta)
+}
+
+func resourceSlackConversationSharedInviteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inviteId := d.Id()
+
+	api := meta.(*Team).api
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":  "slack_conversation_shared_invite",
+		"invite_id": inviteId,
+	})
+
+	logger.trace(ctx, "Start reading the shared invite")
+
+	invite, err := api.findConnectInvite(ctx, inviteId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read the shared invite (%s) due to *%s*", inviteId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.listConnectInvites"),
+			},
+		}
+	}
+
+	if invite == nil {
+		return readSlackConversationSharedInviteNotListed(ctx, logger, d, meta)
+	}
+
+	if invite.Status == connectInviteStatusRevoked || invite.Status == connectInviteStatusDeclined {
+		logger.debug(ctx, "The shared invite is no longer available so remove this resource from the state")
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("invite_id", invite.Invite.ID)
+	_ = d.Set("channel_id", invite.Channel.ID)
+	_ = d.Set("status", invite.Status)
+
+	logger.debug(ctx, "Configured the shared invite (status = %s)", invite.Status)
+
+	return nil
+}
+
+// readSlackConversationSharedInviteNotListed keeps accepted invites in the state because listConnectInvites stops listing them once they are accepted
+func readSlackConversationSharedInviteNotListed(ctx context.Context, logger *Logger, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+
+	channel, err := meta.(*Team).api.getConversationInfo(ctx, channelId)
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			logger.debug(ctx, "The conversation has gone so remove this resource from the state")
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read the slack conversation (%s) of the shared invite (%s) due to *%s*", channelId, d.Id(), err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.info"),
+			},
+		}
+	}
+
+	if !channel.IsExtShared {
+		logger.debug(ctx, "The shared invite is no longer listed and the conversation is not shared so remove this resource from the state")
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("invite_id", d.Id())
+	_ = d.Set("status", connectInviteStatusAccepted)
+
+	logger.debug(ctx, "The shared invite has been accepted")
+
+	return nil
+}
+
+// resourceSlackConversationSharedInviteDelete never revokes pending invites because Slack has no api for senders to revoke a single invite
+func resourceSlackConversationSharedInviteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inviteId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":  "slack_conversation_shared_invite",
+		"invite_id": inviteId,
+	})
+
+	var diags diag.Diagnostics
+
+	if status := d.Get("status").(string); status != connectInviteStatusPending {
+		logger.debug(ctx, "The shared invite is not pending (status = %s) so there is nothing to revoke", status)
+	} else {
+		diags = diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Slack provider couldn't revoke the pending shared invite (%s)", inviteId),
+				Detail:   "Please revoke the invite from the channel settings in Slack if needed.",
+			},
+		}
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this shared invite so it's going to be removed from the state")
+
+	return diags
+}
@@ -0,0 +1,260 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+func resourceSlackConversationSharedInviteAcceptance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationSharedInviteAcceptanceRead,
+		CreateContext: resourceSlackConversationSharedInviteAcceptanceCreate,
+		DeleteContext: resourceSlackConversationSharedInviteAcceptanceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"invite_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"channel_name": {
+				Type:        schema.TypeString,
+				Description: "A name of the local conversation",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"is_private": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Description: "A workspace to accept the invite in. Required only on Enterprise Grid",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"approve": {
+				Type:        schema.TypeBool,
+				Description: "Whether to approve the invite if admin approval is required. admin_token of the provider approves it if it is set",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Description: "An id of the local conversation. Use it to import the conversation as slack_conversation",
+				Computed:    true,
+			},
+			"implicit_approval": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationSharedInviteAcceptanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inviteId := d.Get("invite_id").(string)
+	channelName := d.Get("channel_name").(string)
+
+	api := meta.(*Team).api
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":  "slack_conversation_shared_invite_acceptance",
+		"invite_id": inviteId,
+	})
+
+	logger.trace(ctx, "Start accepting the shared invite")
+
+	accepted, err := api.acceptSharedInvite(ctx, inviteId, channelName, d.Get("is_private").(bool), d.Get("team_id").(string))
+
+	needsApproval := true
+
+	if err != nil && err.Error() == "already_accepted" {
+		// A previous apply may have failed to approve it after accepting, so retry only the approval
+		logger.debug(ctx, "The shared invite has already been accepted")
+
+		var diags diag.Diagnostics
+
+		if accepted, needsApproval, diags = findAcceptedSlackSharedInvite(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+
+		err = nil
+	}
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't accept the shared invite (%s) as #%s due to *%s*", inviteId, channelName, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.acceptSharedInvite"),
+			},
+		}
+	} else {
+		logger.trace(ctx, "Got a response from Slack API")
+	}
+
+	d.SetId(inviteId)
+
+	if accepted.ChannelID != "" {
+		_ = d.Set("channel_id", accepted.ChannelID)
+	}
+
+	_ = d.Set("implicit_approval", accepted.ImplicitApproval)
+
+	if accepted.ImplicitApproval {
+		logger.debug(ctx, "The shared invite has been approved implicitly")
+	} else if !needsApproval {
+		logger.debug(ctx, "The shared invite has already been approved")
+	} else if d.Get("approve").(bool) {
+		// Approvals usually need an admin
+		approver := api
+
+		if meta.(*Team).adminApi != nil {
+			approver = meta.(*Team).adminApi
+		}
+
+		if err := approver.approveSharedInvite(ctx, inviteId); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider accepted but couldn't approve the shared invite (%s) due to *%s*", inviteId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.approveSharedInvite"),
+				},
+			}
+		}
+
+		logger.trace(ctx, "Approved the shared invite")
+	} else {
+		logger.debug(ctx, "The shared invite is waiting for admin approval")
+	}
+
+	return resourceSlackConversationSharedInviteAcceptanceRead(ctx, d, meta)
+}
+
+// findAcceptedSlackSharedInvite resolves the local conversation of an invite that has already been accepted and whether it still needs an approval.
+// The conversation is looked up by channel_name if the invite is no longer listed
+func findAcceptedSlackSharedInvite(ctx context.Context, d *schema.ResourceData, meta interface{}) (*acceptSharedInviteResponse, bool, diag.Diagnostics) {
+	inviteId := d.Get("invite_id").(string)
+	channelName := d.Get("channel_name").(string)
+
+	api := meta.(*Team).api
+
+	accepted := &acceptSharedInviteResponse{InviteID: inviteId}
+
+	invite, err := api.findConnectInvite(ctx, inviteId)
+
+	if err != nil {
+		return nil, false, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read the accepted shared invite (%s) due to *%s*", inviteId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.listConnectInvites"),
+			},
+		}
+	}
+
+	needsApproval := invite != nil && invite.Status != connectInviteStatusApproved
+
+	if invite != nil && invite.Channel.ID != "" {
+		accepted.ChannelID = invite.Channel.ID
+		return accepted, needsApproval, nil
+	}
+
+	types := []string{"public_channel"}
+
+	if d.Get("is_private").(bool) {
+		types = []string{"private_channel"}
+	}
+
+	channels, err := getConversationsWithCache(ctx, api, types)
+
+	if err != nil {
+		return nil, false, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't list conversations to find #%s of the accepted shared invite (%s) due to *%s*", channelName, inviteId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.list"),
+			},
+		}
+	}
+
+	for _, channel := range channels {
+		if channel.Name == channelName && !channel.IsArchived {
+			accepted.ChannelID = channel.ID
+			break
+		}
+	}
+
+	return accepted, needsApproval, nil
+}
+
+func resourceSlackConversationSharedInviteAcceptanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inviteId := d.Id()
+
+	api := meta.(*Team).api
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":  "slack_conversation_shared_invite_acceptance",
+		"invite_id": inviteId,
+	})
+
+	logger.trace(ctx, "Start reading the shared invite")
+
+	invite, err := api.findConnectInvite(ctx, inviteId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read the shared invite (%s) due to *%s*", inviteId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.listConnectInvites"),
+			},
+		}
+	}
+
+	// An acceptance cannot be undone so keep the state even if the invite is no longer listed
+	if invite == nil {
+		logger.debug(ctx, "The shared invite is no longer listed")
+		return nil
+	}
+
+	if invite.Channel.ID != "" {
+		_ = d.Set("channel_id", invite.Channel.ID)
+	}
+
+	_ = d.Set("status", invite.Status)
+
+	logger.debug(ctx, "Configured the shared invite acceptance (status = %s)", invite.Status)
+
+	return nil
+}
+
+func resourceSlackConversationSharedInviteAcceptanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":  "slack_conversation_shared_invite_acceptance",
+		"invite_id": d.Id(),
+	})
+
+	logger.debug(ctx, "An acceptance of shared invites cannot be undone so does nothing on destroy")
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this shared invite acceptance so it's going to be removed from the state")
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+	"net/http"
+	"testing"
+)
+
+func testConnectInvite(id string, channelId string, status string) connectInvite {
+	invite := connectInvite{
+		Direction: "outgoing",
+		Status:    status,
+	}
+	invite.Invite.ID = id
+	invite.Channel.ID = channelId
+	return invite
+}
+
+func Test_ResourceConversationSharedInviteRead(t *testing.T) {
+	cases := []struct {
+		InviteId     string
+		ExtShared    bool
+		ExpectId     string
+		ExpectStatus string
+	}{
+		{
+			InviteId:     "I0001",
+			ExpectId:     "I0001",
+			ExpectStatus: "pending",
+		},
+		{
+			InviteId: "I0002",
+			ExpectId: "",
+		},
+		{
+			// accepted invites are no longer listed
+			InviteId:     "I0003",
+			ExtShared:    true,
+			ExpectId:     "I0003",
+			ExpectStatus: "accepted",
+		},
+		{
+			InviteId: "I0003",
+			ExpectId: "",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationSharedInvite().TestResourceData()
+		d.SetId(tc.InviteId)
+		if err := d.Set("channel_id", "C0001"); err != nil {
+			t.Fatalf("err set channel_id: %s", err)
+		}
+
+		channel := conversation{}
+		channel.ID = "C0001"
+		channel.IsExtShared = tc.ExtShared
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.listConnectInvites",
+				Response: connectInvitesResponse{
+					slack.SlackResponse{Ok: true},
+					[]connectInvite{
+						testConnectInvite("I0001", "C0001", connectInviteStatusPending),
+						testConnectInvite("I0002", "C0001", connectInviteStatusRevoked),
+					},
+				},
+			},
+			{
+				Path:     "/conversations.info",
+				Response: conversationResponse{slack.SlackResponse{Ok: true}, channel},
+			},
+		})
+
+		if diags := resourceSlackConversationSharedInviteRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("expect id to be %s, but got %s", tc.ExpectId, d.Id())
+		}
+
+		if status := d.Get("status").(string); tc.ExpectId != "" && status != tc.ExpectStatus {
+			t.Fatalf("expect status to be %s, but got %s", tc.ExpectStatus, status)
+		}
+	}
+}
+
+func Test_ResourceConversationSharedInviteDelete(t *testing.T) {
+	cases := []struct {
+		Status        string
+		ExpectWarning bool
+	}{
+		{
+			Status:        connectInviteStatusPending,
+			ExpectWarning: true,
+		},
+		{
+			Status:        connectInviteStatusAccepted,
+			ExpectWarning: false,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationSharedInvite().TestResourceData()
+		d.SetId("I0001")
+		if err := d.Set("channel_id", "C0001"); err != nil {
+			t.Fatalf("err set channel_id: %s", err)
+		}
+		if err := d.Set("status", tc.Status); err != nil {
+			t.Fatalf("err set status: %s", err)
+		}
+
+		// Nothing must be called
+		ctx, team := createTestTeam(t, Routes{})
+		team.adminApi = team.api
+
+		diags := resourceSlackConversationSharedInviteDelete(ctx, d, team)
+
+		if diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if tc.ExpectWarning != (len(diags) > 0) {
+			t.Fatalf("expect a warning to revoke the invite manually (%t), but got %v", tc.ExpectWarning, diags)
+		}
+
+		if d.Id() != "" {
+			t.Fatalf("expect id to be empty, but got %s", d.Id())
+		}
+	}
+}
+
+func Test_ResourceConversationSharedInviteAcceptanceCreate(t *testing.T) {
+	d := resourceSlackConversationSharedInviteAcceptance().TestResourceData()
+	if err := d.Set("invite_id", "I0001"); err != nil {
+		t.Fatalf("err set invite_id: %s", err)
+	}
+	if err := d.Set("channel_name", "shared-with-vendor"); err != nil {
+		t.Fatalf("err set channel_name: %s", err)
+	}
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.acceptSharedInvite",
+			Response: acceptSharedInviteResponse{
+				slack.SlackResponse{Ok: true},
+				true,
+				"C0001",
+				"I0001",
+			},
+		},
+		{
+			Path: "/conversations.listConnectInvites",
+			Response: connectInvitesResponse{
+				slack.SlackResponse{Ok: true},
+				[]connectInvite{},
+			},
+		},
+	})
+
+	if diags := resourceSlackConversationSharedInviteAcceptanceCreate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if d.Id() != "I0001" {
+		t.Fatalf("expect id to be I0001, but got %s", d.Id())
+	}
+
+	if channelId := d.Get("channel_id").(string); channelId != "C0001" {
+		t.Fatalf("expect channel_id to be C0001, but got %s", channelId)
+	}
+}
+
+func Test_ResourceConversationSharedInviteAcceptanceCreateAfterAccepted(t *testing.T) {
+	cases := []struct {
+		Invites        []connectInvite
+		ExpectApproval bool
+	}{
+		{
+			// A previous apply failed to approve it
+			Invites:        []connectInvite{testConnectInvite("I0001", "C0001", connectInviteStatusPending)},
+			ExpectApproval: true,
+		},
+		{
+			Invites:        []connectInvite{testConnectInvite("I0001", "C0001", connectInviteStatusApproved)},
+			ExpectApproval: false,
+		},
+		{
+			// The conversation is looked up by name
+			Invites:        []connectInvite{},
+			ExpectApproval: false,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversationSharedInviteAcceptance().TestResourceData()
+		if err := d.Set("invite_id", "I0001"); err != nil {
+			t.Fatalf("err set invite_id: %s", err)
+		}
+		if err := d.Set("channel_name", "shared-with-vendor"); err != nil {
+			t.Fatalf("err set channel_name: %s", err)
+		}
+		if err := d.Set("approve", true); err != nil {
+			t.Fatalf("err set approve: %s", err)
+		}
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path:     "/conversations.acceptSharedInvite",
+				Response: slack.SlackResponse{Ok: false, Error: "already_accepted"},
+			},
+			{
+				Path: "/conversations.listConnectInvites",
+				Response: connectInvitesResponse{
+					slack.SlackResponse{Ok: true},
+					tc.Invites,
+				},
+			},
+			{
+				Path: "/conversations.list",
+				Response: conversationsListResponse{
+					slack.SlackResponse{Ok: true},
+					[]slack.Channel{
+						testChannel("C0002", "shared-with-vendor", true),
+						testChannel("C0001", "shared-with-vendor", false),
+					},
+				},
+			},
+		})
+
+		approved := false
+
+		// Only admin_token approves the invite
+		_, admin := createTestTeam(t, Routes{
+			{
+				Path: "/conversations.approveSharedInvite",
+				Response: func(r *http.Request) interface{} {
+					approved = r.FormValue("invite_id") == "I0001"
+					return slack.SlackResponse{Ok: true}
+				},
+			},
+		})
+		team.adminApi = admin.api
+
+		if diags := resourceSlackConversationSharedInviteAcceptanceCreate(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if approved != tc.ExpectApproval {
+			t.Fatalf("expect the approval to be retried (%t), but got %t", tc.ExpectApproval, approved)
+		}
+
+		if channelId := d.Get("channel_id").(string); channelId != "C0001" {
+			t.Fatalf("expect channel_id to be C0001, but got %s", channelId)
+		}
+	}
+}
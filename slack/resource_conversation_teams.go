@@ -0,0 +1,170 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+func resourceSlackConversationTeams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationTeamsRead,
+		CreateContext: resourceSlackConversationTeamsCreate,
+		UpdateContext: resourceSlackConversationTeamsUpdate,
+		DeleteContext: resourceSlackConversationTeamsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("channel_id", d.Id())
+				// org_channel is never read back so assume the default
+				_ = d.Set("org_channel", false)
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_ids": {
+				Type:        schema.TypeSet,
+				Description: "Workspaces that the conversation is connected to",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"org_channel": {
+				Type:        schema.TypeBool,
+				Description: "Whether to convert the conversation to an org-wide conversation. This is write-only because Slack has no api to read it, so changes outside Terraform are not detected",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationTeamsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Get("channel_id").(string)
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_teams",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start connecting workspaces to the conversation")
+
+	if diags := setSlackConversationTeams(ctx, logger, d, meta, channelId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(channelId)
+
+	return resourceSlackConversationTeamsRead(ctx, d, meta)
+}
+
+func resourceSlackConversationTeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_teams",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start reading workspaces of the conversation")
+
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't read workspaces of the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	teamIds, err := adminApi.getConversationTeams(ctx, channelId)
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read workspaces of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.getTeams"),
+			},
+		}
+	}
+
+	_ = d.Set("channel_id", channelId)
+	_ = d.Set("team_ids", teamIds)
+
+	logger.debug(ctx, "Configured %d workspaces of the conversation", len(teamIds))
+
+	return nil
+}
+
+func resourceSlackConversationTeamsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	channelId := d.Id()
+
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_teams",
+		"conversation_id": channelId,
+	})
+
+	logger.trace(ctx, "Start updating workspaces of the conversation")
+
+	if diags := setSlackConversationTeams(ctx, logger, d, meta, channelId); diags.HasError() {
+		return diags
+	}
+
+	return resourceSlackConversationTeamsRead(ctx, d, meta)
+}
+
+func resourceSlackConversationTeamsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":        "slack_conversation_teams",
+		"conversation_id": d.Id(),
+	})
+
+	// Cannot disconnect all workspaces, so let me keep them
+	logger.debug(ctx, "A conversation must be connected to one workspace at least so does nothing on destroy")
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this conversation workspaces' resource so it's going to be removed from the state")
+
+	return nil
+}
+
+func setSlackConversationTeams(ctx context.Context, logger *Logger, d *schema.ResourceData, meta interface{}, channelId string) diag.Diagnostics {
+	adminApi, diags := meta.(*Team).requireAdminApi(fmt.Sprintf("Slack provider couldn't set workspaces of the slack conversation (%s) without an admin token", channelId))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	o, n := d.GetChange("team_ids")
+	teamIds := schemaSetToStrings(n.(*schema.Set))
+
+	logger.debug(ctx, "Connect %v and disconnect %v", n.(*schema.Set).Difference(o.(*schema.Set)).List(), o.(*schema.Set).Difference(n.(*schema.Set)).List())
+
+	if err := adminApi.setConversationTeams(ctx, channelId, teamIds, d.Get("org_channel").(bool)); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't set workspaces of the slack conversation (%s) due to *%s*", channelId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/admin.conversations.setTeams"),
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"testing"
+)
+
+func Test_ResourceConversationTeamsRead(t *testing.T) {
+	d := resourceSlackConversationTeams().TestResourceData()
+	d.SetId("C0001")
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/admin.conversations.getTeams",
+			Response: adminConversationTeamsResponse{
+				slack.SlackResponse{Ok: true},
+				[]string{"T0001", "T0002"},
+			},
+		},
+	})
+
+	team.adminApi = team.api
+
+	if diags := resourceSlackConversationTeamsRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if teamIds := d.Get("team_ids").(*schema.Set); teamIds.Len() != 2 || !teamIds.Contains("T0002") {
+		t.Fatalf("expect T0001 and T0002 but got %v", teamIds.List())
+	}
+}
+
+func Test_ResourceConversationTeamsReadWithoutAdminToken(t *testing.T) {
+	d := resourceSlackConversationTeams().TestResourceData()
+	d.SetId("C0001")
+
+	ctx, team := createTestTeam(t, Routes{})
+
+	if diags := resourceSlackConversationTeamsRead(ctx, d, team); !diags.HasError() {
+		t.Fatalf("expect an error without an admin token")
+	}
+}
+
+func Test_ResourceConversationTeamsImport(t *testing.T) {
+	d := resourceSlackConversationTeams().TestResourceData()
+	d.SetId("C0001")
+
+	if _, err := resourceSlackConversationTeams().Importer.StateContext(context.Background(), d, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Otherwise the default of org_channel appears as a diff right after importing
+	if orgChannel := d.State().Attributes["org_channel"]; orgChannel != "false" {
+		t.Fatalf("expect org_channel to be imported as false, but got %q", orgChannel)
+	}
+}
@@ -0,0 +1,339 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"net/http"
+	"testing"
+)
+
+func Test_ResourceConversationReadPostingRestrictions(t *testing.T) {
+	d := resourceSlackConversation().TestResourceData()
+	d.SetId("C0001")
+	if err := d.Set("posting_restrictions", []interface{}{
+		map[string]interface{}{
+			"who_can_post_types": []interface{}{"admin"},
+		},
+	}); err != nil {
+		t.Fatalf("err set posting_restrictions: %s", err)
+	}
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.info",
+			Response: conversationResponse{
+				slack.SlackResponse{Ok: true},
+				conversation{Channel: testChannel("C0001", "announcement", false)},
+			},
+		},
+		{
+			Path: "/admin.conversations.getConversationPrefs",
+			Response: conversationPrefsResponse{
+				slack.SlackResponse{Ok: true},
+				conversationPrefs{
+					WhoCanPost: conversationPrefEntities{
+						Types:      []string{"admin"},
+						UserGroups: []string{"S0001"},
+					},
+				},
+			},
+		},
+	})
+
+	team.adminApi = team.api
+
+	if diags := resourceSlackConversationRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	restrictions := d.Get("posting_restrictions").([]interface{})[0].(map[string]interface{})
+
+	if usergroups := restrictions["who_can_post_usergroups"].(*schema.Set); usergroups.Len() != 1 || !usergroups.Contains("S0001") {
+		t.Fatalf("expect S0001 can post but got %v", usergroups.List())
+	}
+}
+
+func Test_ResourceConversationCreatePostingRestrictionsWithoutAdminToken(t *testing.T) {
+	d := resourceSlackConversation().TestResourceData()
+	d.SetId("C0001")
+	if err := d.Set("posting_restrictions", []interface{}{
+		map[string]interface{}{
+			"who_can_post_types": []interface{}{"admin"},
+		},
+	}); err != nil {
+		t.Fatalf("err set posting_restrictions: %s", err)
+	}
+
+	ctx, team := createTestTeam(t, Routes{})
+
+	if diags := updateSlackConversationPostingRestrictions(ctx, team.logger, d, team); !diags.HasError() {
+		t.Fatalf("expect an error without an admin token")
+	}
+}
+
+func Test_ResourceConversationRemovePostingRestrictions(t *testing.T) {
+	d := resourceSlackConversation().TestResourceData()
+	d.SetId("C0001")
+
+	var prefs []string
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/admin.conversations.setConversationPrefs",
+			Response: func(r *http.Request) interface{} {
+				prefs = append(prefs, r.FormValue("prefs"))
+				return slack.SlackResponse{Ok: true}
+			},
+		},
+	})
+
+	team.adminApi = team.api
+
+	if diags := updateSlackConversationPostingRestrictions(ctx, team.logger, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	// Empty values remove restrictions of the conversation
+	if expected := `{"can_thread":"","who_can_post":""}`; len(prefs) != 1 || prefs[0] != expected {
+		t.Fatalf("expect %s to be sent but got %v", expected, prefs)
+	}
+}
+
+func Test_ResourceConversationImportPostingRestrictions(t *testing.T) {
+	cases := []struct {
+		Prefs        conversationPrefs
+		ExpectImport bool
+	}{
+		{
+			Prefs: conversationPrefs{
+				WhoCanPost: conversationPrefEntities{
+					Types: []string{"admin"},
+				},
+			},
+			ExpectImport: true,
+		},
+		{
+			Prefs:        conversationPrefs{},
+			ExpectImport: false,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversation().TestResourceData()
+		d.SetId("C0001")
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/admin.conversations.getConversationPrefs",
+				Response: conversationPrefsResponse{
+					slack.SlackResponse{Ok: true},
+					tc.Prefs,
+				},
+			},
+		})
+
+		team.adminApi = team.api
+
+		if _, err := importSlackConversation(ctx, d, team); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if _, ok := d.GetOk("posting_restrictions"); ok != tc.ExpectImport {
+			t.Fatalf("expect posting_restrictions to be imported (%t) but got %v", tc.ExpectImport, d.Get("posting_restrictions"))
+		}
+	}
+}
+
+func Test_ResourceConversationCreateOnEnterpriseGrid(t *testing.T) {
+	cases := []struct {
+		VisibleToAdmin bool
+		ExpectName     string
+		ExpectWarning  bool
+	}{
+		{
+			VisibleToAdmin: true,
+			ExpectName:     "announcement",
+		},
+		{
+			VisibleToAdmin: false,
+			ExpectName:     "configured",
+			ExpectWarning:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversation().TestResourceData()
+		if err := d.Set("name", "configured"); err != nil {
+			t.Fatalf("err set name: %s", err)
+		}
+		if err := d.Set("org_wide", true); err != nil {
+			t.Fatalf("err set org_wide: %s", err)
+		}
+
+		calls := 0
+		visibleToAdmin := tc.VisibleToAdmin
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/admin.conversations.create",
+				Response: adminConversationCreateResponse{
+					slack.SlackResponse{Ok: true},
+					"C0001",
+				},
+			},
+			{
+				// The first call uses the token, which is not a member of the conversation
+				Path: "/conversations.info",
+				Response: func(r *http.Request) interface{} {
+					calls++
+
+					if calls == 1 || !visibleToAdmin {
+						return slack.SlackResponse{Ok: false, Error: "channel_not_found"}
+					}
+
+					channel := conversation{Channel: testChannel("C0001", "announcement", false)}
+					channel.IsOrgShared = true
+
+					return conversationResponse{
+						slack.SlackResponse{Ok: true},
+						channel,
+					}
+				},
+			},
+		})
+
+		team.adminApi = team.api
+
+		diags := resourceSlackConversationCreate(ctx, d, team)
+
+		if diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != "C0001" {
+			t.Fatalf("expect id to be C0001, but got %s", d.Id())
+		}
+
+		if name := d.Get("name").(string); name != tc.ExpectName {
+			t.Fatalf("expect name to be %s, but got %s", tc.ExpectName, name)
+		}
+
+		if tc.ExpectWarning != (len(diags) > 0) {
+			t.Fatalf("expect a warning to be %t, but got %v", tc.ExpectWarning, diags)
+		}
+
+		if !d.Get("org_wide").(bool) {
+			t.Fatalf("expect org_wide to be kept")
+		}
+
+		// The next refresh keeps the state until the conversation becomes visible
+		diags = resourceSlackConversationRead(ctx, d, team)
+
+		if diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if d.Id() != "C0001" {
+			t.Fatalf("expect id to be kept, but got %s", d.Id())
+		}
+	}
+}
+
+func Test_ResourceConversationReadEnterpriseGridAttributes(t *testing.T) {
+	cases := []struct {
+		IsOrgShared   bool
+		ContextTeamId string
+	}{
+		{
+			IsOrgShared:   true,
+			ContextTeamId: "E0001",
+		},
+		{
+			IsOrgShared:   false,
+			ContextTeamId: "T0001",
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversation().TestResourceData()
+		d.SetId("C0001")
+
+		channel := conversation{Channel: testChannel("C0001", "announcement", false)}
+		channel.IsOrgShared = tc.IsOrgShared
+		channel.ContextTeamID = tc.ContextTeamId
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path:     "/conversations.info",
+				Response: conversationResponse{slack.SlackResponse{Ok: true}, channel},
+			},
+		})
+
+		if diags := resourceSlackConversationRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if orgWide := d.Get("org_wide").(bool); orgWide != tc.IsOrgShared {
+			t.Fatalf("expect org_wide to be %t, but got %t", tc.IsOrgShared, orgWide)
+		}
+
+		if teamId := d.Get("team_id").(string); teamId != tc.ContextTeamId {
+			t.Fatalf("expect team_id to be %s, but got %s", tc.ContextTeamId, teamId)
+		}
+	}
+}
+
+func Test_conversationPrefEntitiesFormat(t *testing.T) {
+	entities := conversationPrefEntities{
+		Types:      []string{"admin"},
+		Users:      []string{"U0001"},
+		UserGroups: []string{"S0001"},
+	}
+
+	if actual := entities.format(); actual != "type:admin,user:U0001,subteam:S0001" {
+		t.Fatalf("unexpected format %s", actual)
+	}
+}
+
+func Test_ensureSlackConversationMembership(t *testing.T) {
+	cases := []struct {
+		IsPrivate   bool
+		IsMember    bool
+		ExpectError bool
+	}{
+		{
+			IsPrivate:   false,
+			IsMember:    false,
+			ExpectError: false,
+		},
+		{
+			IsPrivate:   true,
+			IsMember:    true,
+			ExpectError: false,
+		},
+		{
+			IsPrivate:   true,
+			IsMember:    false,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackConversation().TestResourceData()
+		d.SetId("C0001")
+		_ = d.Set("ensure_bot_membership", true)
+		_ = d.Set("is_private", tc.IsPrivate)
+		_ = d.Set("is_member", tc.IsMember)
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path:     "/conversations.join",
+				Response: slack.SlackResponse{Ok: true},
+			},
+		})
+
+		if diags := ensureSlackConversationMembership(ctx, team.logger, d, team); diags.HasError() != tc.ExpectError {
+			t.Fatalf("expect error %t but got %v (isPrivate = %t, isMember = %t)", tc.ExpectError, diags, tc.IsPrivate, tc.IsMember)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/slack-go/slack"
+	"time"
 )
 
 const userGroupListCacheFileName = "usergroups.json"
@@ -21,6 +22,13 @@ func resourceSlackUserGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"handle": {
 				Type:     schema.TypeString,
@@ -40,6 +48,18 @@ func resourceSlackUserGroup() *schema.Resource {
 				Default:          "",
 				ValidateDiagFunc: validateEnums([]string{"admins", "owners", ""}),
 			},
+			"adopt_disabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable and adopt a disabled usergroup that has the same handle or name on creation. Never adopted if an enabled usergroup owns either of them",
+				Optional:    true,
+				Default:     true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the usergroup is enabled. Disabled usergroups keep their settings. slack_usergroup_members keeps disabled usergroups disabled while updating members",
+				Optional:    true,
+				Default:     true,
+			},
 			"team_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,6 +75,7 @@ func configureSlackUserGroup(ctx context.Context, logger *Logger, d *schema.Reso
 	_ = d.Set("description", userGroup.Description)
 	_ = d.Set("auto_type", userGroup.AutoType)
 	_ = d.Set("team_id", userGroup.TeamID)
+	_ = d.Set("enabled", userGroup.DateDelete == 0)
 
 	logger.debug(ctx, "Configured UserGroup #%s @%s", d.Id(), d.Get("handle").(string))
 }
@@ -87,19 +108,45 @@ func resourceSlackUserGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	userGroup, err := client.CreateUserGroupContext(ctx, *newUserGroup)
 
 	if err != nil {
-		return diag.Diagnostics{
+		createDiags := diag.Diagnostics{
 			{
 				Severity: diag.Error,
 				Summary:  fmt.Sprintf("Slack provider couldn't create a slack usergroup (%s) due to *%s*", handle, err.Error()),
 				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.create"),
 			},
 		}
+
+		// Slack cannot delete usergroups so a destroyed usergroup still occupies the handle and the name
+		if !d.Get("adopt_disabled").(bool) || (err.Error() != "handle_already_exists" && err.Error() != "name_already_exists") {
+			return createDiags
+		}
+
+		adopted, diags := adoptDisabledSlackUserGroup(ctx, logger, client, *newUserGroup, err.Error())
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if adopted == nil {
+			return createDiags
+		}
+
+		userGroup = *adopted
 	} else {
 		logger.trace(ctx, "Got a response from Slack API")
 	}
 
 	configureSlackUserGroup(ctx, logger, d, userGroup)
 
+	if !d.Get("enabled").(bool) {
+		// The usergroup must be created once to get its id
+		if diags := setSlackUserGroupEnabled(ctx, logger, client, userGroup.ID, false); diags.HasError() {
+			return diags
+		}
+
+		_ = d.Set("enabled", false)
+	}
+
 	return nil
 }
 
@@ -115,35 +162,19 @@ func resourceSlackUserGroupRead(ctx context.Context, d *schema.ResourceData, met
 	logger.trace(ctx, "Start reading a usergroup")
 
 	// Use a cache for usergroups api call because the limitation is strict
-	var userGroups *[]slack.UserGroup
-
-	if !restoreJsonCache(userGroupListCacheFileName, &userGroups) {
-		tempUserGroups, err := client.GetUserGroupsContext(ctx, func(params *slack.GetUserGroupsParams) {
-			params.IncludeUsers = false
-			params.IncludeCount = false
-			params.IncludeDisabled = true
-		})
+	userGroups, err := getUserGroupsWithCache(ctx, client)
 
-		if err != nil {
-			return diag.Diagnostics{
-				{
-					Severity: diag.Error,
-					Summary:  fmt.Sprintf("Slack provider couldn't find slack usergroups due to *%s*", err.Error()),
-					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.list"),
-				},
-			}
-		} else {
-			logger.trace(ctx, "Got a response from Slack API")
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't find slack usergroups due to *%s*", err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.list"),
+			},
 		}
-
-		userGroups = &tempUserGroups
-
-		saveCacheAsJson(userGroupListCacheFileName, &userGroups)
-	} else {
-		logger.trace(ctx, "Read usergroups from the cahed")
 	}
 
-	for _, userGroup := range *userGroups {
+	for _, userGroup := range userGroups {
 		if userGroup.ID == id {
 			configureSlackUserGroup(ctx, logger, d, userGroup)
 			return nil
@@ -164,7 +195,7 @@ func resourceSlackUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	client := meta.(*Team).client
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
-		"resource":     "slack_conversation",
+		"resource":     "slack_usergroup",
 		"usergroup_id": id,
 	})
 
@@ -178,29 +209,59 @@ func resourceSlackUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		name = value.(string)
 	}
 
-	editedUserGroup := &slack.UserGroup{
-		ID:          id,
-		Handle:      handle,
-		Name:        name,
-		Description: d.Get("description").(string),
-		AutoType:    d.Get("auto_type").(string),
+	o, _ := d.GetChange("enabled")
+	wasEnabled := o.(bool)
+	enabled := d.Get("enabled").(bool)
+	hasUpdates := d.HasChanges("handle", "name", "description", "auto_type")
+
+	// Disabled usergroups reject updates so enable it first even if it stays disabled
+	if !wasEnabled && (enabled || hasUpdates) {
+		if diags := setSlackUserGroupEnabled(ctx, logger, client, id, true); diags.HasError() {
+			return diags
+		}
 	}
 
-	userGroup, err := client.UpdateUserGroupContext(ctx, *editedUserGroup)
+	if hasUpdates {
+		editedUserGroup := &slack.UserGroup{
+			ID:          id,
+			Handle:      handle,
+			Name:        name,
+			Description: d.Get("description").(string),
+			AutoType:    d.Get("auto_type").(string),
+		}
 
-	if err != nil {
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Slack provider couldn't update the slack usergroup (%s) due to *%s*", id, err.Error()),
-				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.update"),
-			},
+		userGroup, err := client.UpdateUserGroupContext(ctx, *editedUserGroup)
+
+		if err != nil {
+			diags := diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't update the slack usergroup (%s) due to *%s*", id, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.update"),
+				},
+			}
+
+			if !wasEnabled && !enabled {
+				// Don't leave it enabled just for the failed update
+				diags = append(diags, setSlackUserGroupEnabled(ctx, logger, client, id, false)...)
+			}
+
+			return diags
+		} else {
+			logger.trace(ctx, "Got a response from Slack API")
 		}
-	} else {
-		logger.trace(ctx, "Got a response from Slack API")
+
+		configureSlackUserGroup(ctx, logger, d, userGroup)
 	}
 
-	configureSlackUserGroup(ctx, logger, d, userGroup)
+	if !enabled && (wasEnabled || hasUpdates) {
+		if diags := setSlackUserGroupEnabled(ctx, logger, client, id, false); diags.HasError() {
+			return diags
+		}
+	}
+
+	_ = d.Set("enabled", enabled)
+
 	return nil
 }
 
@@ -237,3 +298,130 @@ func resourceSlackUserGroupDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// adoptDisabledSlackUserGroup enables and updates a disabled usergroup that has the conflicting handle or name.
+// Returns nil if no such usergroup exists or an enabled usergroup owns the handle or the name
+func adoptDisabledSlackUserGroup(ctx context.Context, logger *Logger, client *slack.Client, newUserGroup slack.UserGroup, conflict string) (*slack.UserGroup, diag.Diagnostics) {
+	// Don't use the cache because the usergroup may have been disabled just now
+	userGroups, err := fetchUserGroups(ctx, client)
+
+	if err != nil {
+		return nil, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't find slack usergroups due to *%s*", err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.list"),
+			},
+		}
+	}
+
+	var disabled *slack.UserGroup
+
+	for i := range userGroups {
+		sameHandle := userGroups[i].Handle == newUserGroup.Handle
+		sameName := userGroups[i].Name == newUserGroup.Name
+
+		if userGroups[i].DateDelete == 0 {
+			if sameHandle || sameName {
+				// The update would conflict with the enabled usergroup after enabling the disabled one
+				logger.debug(ctx, "An enabled usergroup #%s owns the handle @%s or the name %s", userGroups[i].ID, newUserGroup.Handle, newUserGroup.Name)
+				return nil, nil
+			}
+
+			continue
+		}
+
+		if disabled == nil && ((conflict == "name_already_exists" && sameName) || (conflict != "name_already_exists" && sameHandle)) {
+			disabled = &userGroups[i]
+		}
+	}
+
+	if disabled == nil {
+		logger.debug(ctx, "No disabled usergroup has the handle @%s or the name %s", newUserGroup.Handle, newUserGroup.Name)
+		return nil, nil
+	}
+
+	logger.debug(ctx, "Adopt the disabled usergroup #%s @%s", disabled.ID, disabled.Handle)
+
+	if diags := setSlackUserGroupEnabled(ctx, logger, client, disabled.ID, true); diags.HasError() {
+		return nil, diags
+	}
+
+	newUserGroup.ID = disabled.ID
+
+	userGroup, err := client.UpdateUserGroupContext(ctx, newUserGroup)
+
+	if err != nil {
+		diags := diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't update the adopted slack usergroup (%s) due to *%s*", disabled.ID, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.update"),
+			},
+		}
+
+		logger.debug(ctx, "Disable the adopted usergroup again to leave it as it was")
+
+		return nil, append(diags, setSlackUserGroupEnabled(ctx, logger, client, disabled.ID, false)...)
+	}
+
+	return &userGroup, nil
+}
+
+func setSlackUserGroupEnabled(ctx context.Context, logger *Logger, client *slack.Client, id string, enabled bool) diag.Diagnostics {
+	if enabled {
+		if _, err := client.EnableUserGroupContext(ctx, id); err != nil && err.Error() != "already_enabled" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't enable the slack usergroup (%s) due to *%s*", id, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.enable"),
+				},
+			}
+		}
+
+		logger.debug(ctx, "Enabled the usergroup")
+	} else {
+		if _, err := client.DisableUserGroupContext(ctx, id); err != nil && err.Error() != "already_disabled" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't disable the slack usergroup (%s) due to *%s*", id, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.disable"),
+				},
+			}
+		}
+
+		logger.debug(ctx, "Disabled the usergroup")
+	}
+
+	return nil
+}
+
+// getUserGroupsWithCache returns all usergroups including disabled ones. The result is shared through the cache because the rate limit of usergroups.list is strict
+func getUserGroupsWithCache(ctx context.Context, client *slack.Client) ([]slack.UserGroup, error) {
+	var userGroups []slack.UserGroup
+
+	if restoreJsonCache(userGroupListCacheFileName, &userGroups) {
+		return userGroups, nil
+	}
+
+	return fetchUserGroups(ctx, client)
+}
+
+// fetchUserGroups calls usergroups.list and refreshes the cache
+func fetchUserGroups(ctx context.Context, client *slack.Client) ([]slack.UserGroup, error) {
+	userGroups, err := client.GetUserGroupsContext(ctx, func(params *slack.GetUserGroupsParams) {
+		params.IncludeUsers = false
+		params.IncludeCount = true
+		params.IncludeDisabled = true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	saveCacheAsJson(userGroupListCacheFileName, &userGroups)
+
+	return userGroups, nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/slack-go/slack"
+	"strings"
+	"time"
 )
 
 func resourceSlackUserGroupChannels() *schema.Resource {
@@ -22,6 +24,13 @@ func resourceSlackUserGroupChannels() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"usergroup_id": {
 				Type:     schema.TypeString,
@@ -35,6 +44,84 @@ func resourceSlackUserGroupChannels() *schema.Resource {
 				Required: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffSlackUserGroupChannels,
+	}
+}
+
+// customizeDiffSlackUserGroupChannels checks the channels in plans because usergroups.update doesn't tell which channel is invalid.
+// Channels that the token cannot view may be valid private channels so they are warned on apply instead
+func customizeDiffSlackUserGroupChannels(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("channels") || !d.NewValueKnown("channels") {
+		return nil
+	}
+
+	o, n := d.GetChange("channels")
+
+	var problems []string
+
+	for _, channelId := range schemaSetToStrings(n.(*schema.Set).Difference(o.(*schema.Set))) {
+		channel, err := meta.(*Team).api.getConversationInfo(ctx, channelId)
+
+		if err != nil {
+			if err.Error() == "channel_not_found" {
+				continue
+			}
+
+			return fmt.Errorf("couldn't read the channel (%s) due to %s", channelId, err.Error())
+		}
+
+		if channel.IsArchived {
+			problems = append(problems, fmt.Sprintf("%s (#%s) is archived", channelId, channel.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("the following channels cannot be default channels: %s", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// splitSlackUserGroupChannels splits channels into public channels and private groups because usergroups.update keeps them as prefs.channels and prefs.groups respectively.
+// Channels that the token cannot view are returned as invisible groups because public channels are visible to any token
+func splitSlackUserGroupChannels(ctx context.Context, meta interface{}, channelIds []string) ([]string, []string, []string, error) {
+	var channels []string
+	var groups []string
+	var invisible []string
+
+	for _, channelId := range channelIds {
+		channel, err := meta.(*Team).api.getConversationInfo(ctx, channelId)
+
+		if err != nil {
+			if err.Error() != "channel_not_found" {
+				return nil, nil, nil, fmt.Errorf("couldn't read the channel (%s) due to *%s*", channelId, err.Error())
+			}
+
+			invisible = append(invisible, channelId)
+			groups = append(groups, channelId)
+		} else if channel.IsPrivate {
+			groups = append(groups, channelId)
+		} else {
+			channels = append(channels, channelId)
+		}
+	}
+
+	return channels, groups, invisible, nil
+}
+
+// warnInvisibleSlackUserGroupChannels warns about channels that the token cannot view because they may be typos of channel ids
+func warnInvisibleSlackUserGroupChannels(usergroupId string, invisible []string) diag.Diagnostics {
+	if len(invisible) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Slack provider couldn't find %d default channels of the slack usergroup (%s)", len(invisible), usergroupId),
+			Detail:   fmt.Sprintf("%s may be private channels that the token owner is not a member of. Please check the channel ids otherwise.", strings.Join(invisible, ", ")),
+		},
 	}
 }
 
@@ -48,7 +135,7 @@ func configureSlackUserGroupChannels(ctx context.Context, logger *Logger, d *sch
 func resourceSlackUserGroupChannelsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	usergroupId := d.Get("usergroup_id").(string)
 
-	client := meta.(*Team).client
+	api := meta.(*Team).api
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
 		"resource":     "slack_usergroup_channels",
 		"usergroup_id": usergroupId,
@@ -56,34 +143,33 @@ func resourceSlackUserGroupChannelsCreate(ctx context.Context, d *schema.Resourc
 
 	logger.trace(ctx, "Start creating the default channels")
 
-	iChannels := d.Get("channels").(*schema.Set).List()
-	channelsIds := make([]string, len(iChannels))
-	for i, v := range iChannels {
-		channelsIds[i] = v.(string)
-	}
+	channels, groups, invisible, err := splitSlackUserGroupChannels(ctx, meta, schemaSetToStrings(d.Get("channels").(*schema.Set)))
 
-	params := &slack.UserGroup{
-		ID: usergroupId,
-		Prefs: slack.UserGroupPrefs{
-			Channels: channelsIds,
-		},
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider %s to add it to the slack usergroup (%s)", err.Error(), usergroupId),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.info"),
+			},
+		}
 	}
 
-	userGroup, err := client.UpdateUserGroupContext(ctx, *params)
+	userGroup, err := api.updateUserGroupChannels(ctx, usergroupId, channels, groups)
 
 	if err != nil {
 		return diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Slack provider couldn't add the default channels to the slack usergroup (%s)", usergroupId),
-				Detail:   err.Error(),
+				Summary:  fmt.Sprintf("Slack provider couldn't add the default channels to the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.update"),
 			},
 		}
 	}
 
-	configureSlackUserGroupChannels(ctx, logger, d, userGroup)
+	configureSlackUserGroupChannels(ctx, logger, d, *userGroup)
 
-	return nil
+	return warnInvisibleSlackUserGroupChannels(usergroupId, invisible)
 }
 
 func resourceSlackUserGroupChannelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -110,56 +196,43 @@ func resourceSlackUserGroupChannelsRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	// Use a cache for usergroups api call because the limitation is strict
-	var userGroups *[]slack.UserGroup
-
-	if !restoreJsonCache(userGroupListCacheFileName, &userGroups) {
-		tempUserGroups, err := client.GetUserGroupsContext(ctx, func(params *slack.GetUserGroupsParams) {
-			params.IncludeUsers = false
-			params.IncludeCount = false
-			params.IncludeDisabled = true
-		})
+	userGroups, err := getUserGroupsWithCache(ctx, client)
 
-		if err != nil {
-			return diag.Diagnostics{
-				{
-					Severity: diag.Error,
-					Summary:  fmt.Sprintf("Slack provider couldn't read the default channels of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
-					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.list"),
-				},
-			}
-		} else {
-			logger.trace(ctx, "Got a response from Slack API")
-		}
-
-		userGroups = &tempUserGroups
-
-		saveCacheAsJson(userGroupListCacheFileName, &userGroups)
-	}
-
-	if userGroups == nil {
+	if err != nil {
 		return diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Serious error happened while reading default channles of the slack usergroup (%s)", usergroupId),
-				Detail:   "Internal provider error. Please open an issue at https://github.com/jmatsu/terraform-provider-slack",
+				Summary:  fmt.Sprintf("Slack provider couldn't read the default channels of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.list"),
 			},
 		}
 	}
 
-	for _, userGroup := range *userGroups {
-		if userGroup.ID == usergroupId {
-			configureSlackUserGroupChannels(ctx, logger, d, userGroup)
-			return nil
+	for _, userGroup := range userGroups {
+		if userGroup.ID != usergroupId {
+			continue
+		}
+
+		// Usergroups cannot be deleted but disabled, and disabled usergroups keep their default channels until they are enabled again
+		if userGroup.DateDelete != 0 {
+			logger.debug(ctx, "The usergroup has been disabled but its default channels are kept")
 		}
+
+		configureSlackUserGroupChannels(ctx, logger, d, userGroup)
+		return nil
 	}
 
+	logger.debug(ctx, "The usergroup has gone so remove this resource from the state")
+
+	d.SetId("")
+
 	return nil
 }
 
 func resourceSlackUserGroupChannelsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	currentId := d.Id()
 
-	client := meta.(*Team).client
+	api := meta.(*Team).api
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
 		"resource":     "slack_usergroup_channels",
 		"usergroup_id": currentId,
@@ -179,20 +252,21 @@ func resourceSlackUserGroupChannelsUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	iChannels := d.Get("channels").(*schema.Set).List()
-	channelsIds := make([]string, len(iChannels))
-	for i, v := range iChannels {
-		channelsIds[i] = v.(string)
-	}
+	o, n := d.GetChange("channels")
 
-	params := &slack.UserGroup{
-		ID: usergroupId,
-		Prefs: slack.UserGroupPrefs{
-			Channels: channelsIds,
-		},
+	channels, groups, invisible, err := splitSlackUserGroupChannels(ctx, meta, schemaSetToStrings(n.(*schema.Set)))
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider %s to add it to the slack usergroup (%s)", err.Error(), usergroupId),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/conversations.info"),
+			},
+		}
 	}
 
-	userGroup, err := client.UpdateUserGroupContext(ctx, *params)
+	userGroup, err := api.updateUserGroupChannels(ctx, usergroupId, channels, groups)
 
 	if err != nil {
 		return diag.Diagnostics{
@@ -204,15 +278,18 @@ func resourceSlackUserGroupChannelsUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	configureSlackUserGroupChannels(ctx, logger, d, userGroup)
+	configureSlackUserGroupChannels(ctx, logger, d, *userGroup)
 
-	return nil
+	// Warn only about added channels not to repeat the same warning in every update
+	added := schemaSetToStrings(n.(*schema.Set).Difference(o.(*schema.Set)))
+
+	return warnInvisibleSlackUserGroupChannels(usergroupId, intersectStrings(invisible, added))
 }
 
 func resourceSlackUserGroupChannelsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	currentId := d.Id()
 
-	client := meta.(*Team).client
+	api := meta.(*Team).api
 	logger := meta.(*Team).logger.withTags(map[string]interface{}{
 		"resource":     "slack_usergroup_channels",
 		"usergroup_id": currentId,
@@ -232,15 +309,8 @@ func resourceSlackUserGroupChannelsDelete(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	params := &slack.UserGroup{
-		ID: usergroupId,
-		Prefs: slack.UserGroupPrefs{
-			Channels: []string{},
-		},
-	}
-
 	// 0 default channels are allowed by spec
-	if _, err := client.UpdateUserGroupContext(ctx, *params); err != nil {
+	if _, err := api.updateUserGroupChannels(ctx, usergroupId, []string{}, []string{}); err != nil {
 		return diag.Diagnostics{
 			{
 				Severity: diag.Error,
@@ -0,0 +1,257 @@
+package slack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func Test_ResourceUserGroupChannelsRead(t *testing.T) {
+	cases := []struct {
+		Id             string
+		ExpectChannels []string
+		ExpectRemoved  bool
+	}{
+		{
+			Id:             "S0001",
+			ExpectChannels: []string{"C0001", "G0001"},
+		},
+		{
+			// disabled usergroups are kept with their stored default channels
+			Id:             "S0002",
+			ExpectChannels: []string{},
+		},
+		{
+			Id:            "S9999",
+			ExpectRemoved: true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackUserGroupChannels().TestResourceData()
+		d.SetId(tc.Id)
+		_ = d.Set("usergroup_id", tc.Id)
+		_ = d.Set("channels", []string{"C0001"})
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/usergroups.list",
+				Response: userGroupsListResponse{
+					slack.SlackResponse{Ok: true},
+					testUserGroups,
+				},
+			},
+		})
+
+		if diags := resourceSlackUserGroupChannelsRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if tc.ExpectRemoved {
+			if d.Id() != "" {
+				t.Fatalf("expect the missing usergroup to be removed from the state, but got %s", d.Id())
+			}
+
+			continue
+		}
+
+		if d.Id() != tc.Id {
+			t.Fatalf("expect id to be %s, but got %s", tc.Id, d.Id())
+		}
+
+		channels := d.Get("channels").(*schema.Set)
+
+		if channels.Len() != len(tc.ExpectChannels) {
+			t.Fatalf("expect channels to be %v, but got %v", tc.ExpectChannels, channels.List())
+		}
+
+		for _, c := range tc.ExpectChannels {
+			if !channels.Contains(c) {
+				t.Fatalf("expect channels to be %v, but got %v", tc.ExpectChannels, channels.List())
+			}
+		}
+	}
+}
+
+func Test_ResourceUserGroupChannelsDelete(t *testing.T) {
+	var submitted url.Values
+
+	d := resourceSlackUserGroupChannels().TestResourceData()
+	d.SetId("S0001")
+	_ = d.Set("usergroup_id", "S0001")
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.update",
+			Response: func(r *http.Request) interface{} {
+				_ = r.ParseForm()
+				submitted = r.PostForm
+				return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: "S0001"}}
+			},
+		},
+	})
+
+	if diags := resourceSlackUserGroupChannelsDelete(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	for _, key := range []string{"channels", "groups"} {
+		if values, ok := submitted[key]; !ok || len(values) != 1 || values[0] != "" {
+			t.Fatalf("expect an empty %s parameter to be submitted, but got %v", key, submitted)
+		}
+	}
+}
+
+func Test_ResourceUserGroupChannelsPlan(t *testing.T) {
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/conversations.info",
+			Response: func(r *http.Request) interface{} {
+				for _, channel := range testChannels {
+					if channel.ID == r.FormValue("channel") {
+						return conversationResponse{slack.SlackResponse{Ok: true}, conversation{Channel: channel}}
+					}
+				}
+
+				return slack.SlackResponse{Ok: false, Error: "channel_not_found"}
+			},
+		},
+	})
+
+	cases := []struct {
+		Channels    []interface{}
+		ExpectError string
+	}{
+		{
+			Channels: []interface{}{"C0001", "C0002"},
+		},
+		{
+			Channels:    []interface{}{"C0001", "C0004"},
+			ExpectError: "C0004 (#archived) is archived",
+		},
+		{
+			// may be a private channel that the token owner is not a member of
+			Channels: []interface{}{"C9999"},
+		},
+	}
+
+	for _, tc := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"usergroup_id": "S0001",
+			"channels":     tc.Channels,
+		})
+
+		_, err := resourceSlackUserGroupChannels().Diff(ctx, nil, config, team)
+
+		if tc.ExpectError == "" {
+			if err != nil {
+				t.Fatalf("%v: err: %s", tc.Channels, err)
+			}
+
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+			t.Fatalf("%v: expect an error to contain %s, but got %v", tc.Channels, tc.ExpectError, err)
+		}
+	}
+}
+
+func Test_ResourceUserGroupChannelsCreateWithInvisibleChannels(t *testing.T) {
+	d := resourceSlackUserGroupChannels().TestResourceData()
+	_ = d.Set("usergroup_id", "S0001")
+	_ = d.Set("channels", []string{"C0001", "G9999"})
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.update",
+			Response: userGroupResponse{
+				slack.SlackResponse{Ok: true},
+				slack.UserGroup{ID: "S0001", Prefs: slack.UserGroupPrefs{Channels: []string{"C0001"}, Groups: []string{"G9999"}}},
+			},
+		},
+		{
+			Path: "/conversations.info",
+			Response: func(r *http.Request) interface{} {
+				for _, channel := range testChannels {
+					if channel.ID == r.FormValue("channel") {
+						return conversationResponse{slack.SlackResponse{Ok: true}, conversation{Channel: channel}}
+					}
+				}
+
+				return slack.SlackResponse{Ok: false, Error: "channel_not_found"}
+			},
+		},
+	})
+
+	diags := resourceSlackUserGroupChannelsCreate(ctx, d, team)
+
+	if diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "G9999") {
+		t.Fatalf("expect a warning about G9999, but got %v", diags)
+	}
+
+	if d.Id() != "S0001" {
+		t.Fatalf("expect id to be S0001, but got %s", d.Id())
+	}
+}
+
+func Test_ResourceUserGroupChannelsCreateWithPrivateChannels(t *testing.T) {
+	var submitted url.Values
+
+	privateChannel := testChannel("G0001", "private", false)
+	privateChannel.IsPrivate = true
+
+	d := resourceSlackUserGroupChannels().TestResourceData()
+	_ = d.Set("usergroup_id", "S0001")
+	_ = d.Set("channels", []string{"C0001", "G0001"})
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.update",
+			Response: func(r *http.Request) interface{} {
+				_ = r.ParseForm()
+				submitted = r.PostForm
+
+				// usergroups.update returns private channels as groups
+				return userGroupResponse{
+					slack.SlackResponse{Ok: true},
+					slack.UserGroup{ID: "S0001", Prefs: slack.UserGroupPrefs{Channels: strings.Split(r.FormValue("channels"), ","), Groups: strings.Split(r.FormValue("groups"), ",")}},
+				}
+			},
+		},
+		{
+			Path: "/conversations.info",
+			Response: func(r *http.Request) interface{} {
+				for _, channel := range append(testChannels, privateChannel) {
+					if channel.ID == r.FormValue("channel") {
+						return conversationResponse{slack.SlackResponse{Ok: true}, conversation{Channel: channel}}
+					}
+				}
+
+				return slack.SlackResponse{Ok: false, Error: "channel_not_found"}
+			},
+		},
+	})
+
+	if diags := resourceSlackUserGroupChannelsCreate(ctx, d, team); len(diags) > 0 {
+		t.Fatalf("expect no diagnostics, but got %v", diags)
+	}
+
+	if submitted.Get("channels") != "C0001" || submitted.Get("groups") != "G0001" {
+		t.Fatalf("expect the private channel to be submitted as groups, but got %v", submitted)
+	}
+
+	channels := d.Get("channels").(*schema.Set)
+
+	if channels.Len() != 2 || !channels.Contains("C0001") || !channels.Contains("G0001") {
+		t.Fatalf("expect channels to be [C0001 G0001], but got %v", channels.List())
+	}
+}
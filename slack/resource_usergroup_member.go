@@ -0,0 +1,225 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"strings"
+	"time"
+)
+
+// usergroups.users.update replaces all members so a concurrent writer may drop the change
+const userGroupMemberMaxAttempts = 5
+
+func resourceSlackUserGroupMember() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackUserGroupMemberRead,
+		CreateContext: resourceSlackUserGroupMemberCreate,
+		DeleteContext: resourceSlackUserGroupMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				usergroupId, userId, err := splitCompositeId(d.Id())
+
+				if err != nil {
+					return nil, err
+				}
+
+				_ = d.Set("usergroup_id", usergroupId)
+				_ = d.Set("user_id", userId)
+				return schema.ImportStatePassthroughContext(ctx, d, m)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"usergroup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSlackUserGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usergroupId := d.Get("usergroup_id").(string)
+	userId := d.Get("user_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":     "slack_usergroup_member",
+		"usergroup_id": usergroupId,
+		"user_id":      userId,
+	})
+
+	logger.trace(ctx, "Start adding the user to the usergroup")
+
+	if diags := setSlackUserGroupMembership(ctx, logger, client, usergroupId, userId, true); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(buildCompositeId(usergroupId, userId))
+
+	return resourceSlackUserGroupMemberRead(ctx, d, meta)
+}
+
+func resourceSlackUserGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := d.Id()
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource": "slack_usergroup_member",
+		"id":       id,
+	})
+
+	logger.trace(ctx, "Start reading the usergroup member")
+
+	usergroupId, userId, err := splitCompositeId(id)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members, err := client.GetUserGroupMembersContext(ctx, usergroupId)
+
+	if err != nil {
+		if err.Error() == "no_such_subteam" {
+			logger.debug(ctx, "The usergroup has gone so remove this resource from the state")
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read members of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.list"),
+			},
+		}
+	}
+
+	if !containsAny(members, userId) {
+		logger.debug(ctx, "The user has been removed from the usergroup so remove this resource from the state")
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("usergroup_id", usergroupId)
+	_ = d.Set("user_id", userId)
+
+	logger.debug(ctx, "Configured the usergroup member")
+
+	return nil
+}
+
+func resourceSlackUserGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usergroupId := d.Get("usergroup_id").(string)
+	userId := d.Get("user_id").(string)
+
+	client := meta.(*Team).client
+	logger := meta.(*Team).logger.withTags(map[string]interface{}{
+		"resource":     "slack_usergroup_member",
+		"usergroup_id": usergroupId,
+		"user_id":      userId,
+	})
+
+	logger.trace(ctx, "Start removing the user from the usergroup")
+
+	diags := setSlackUserGroupMembership(ctx, logger, client, usergroupId, userId, false)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	logger.debug(ctx, "Cleared the resource id of this usergroup member so it's going to be removed from the state")
+
+	return diags
+}
+
+// setSlackUserGroupMembership adds or removes only the user by read-modify-write. It re-reads members after writing and retries if others have overwritten them at the same time
+func setSlackUserGroupMembership(ctx context.Context, logger *Logger, client *slack.Client, usergroupId string, userId string, member bool) diag.Diagnostics {
+	for attempt := 1; ; attempt++ {
+		members, err := client.GetUserGroupMembersContext(ctx, usergroupId)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't read members of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.list"),
+				},
+			}
+		}
+
+		if containsAny(members, userId) == member {
+			logger.debug(ctx, "The membership has been settled (member = %t)", member)
+			return nil
+		}
+
+		if attempt > userGroupMemberMaxAttempts {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't change the membership of %s in the slack usergroup (%s) due to concurrent modifications", userId, usergroupId),
+					Detail:   fmt.Sprintf("Members were overwritten by others %d times. Please try again later.", userGroupMemberMaxAttempts),
+				},
+			}
+		}
+
+		if attempt > 1 {
+			logger.debug(ctx, "Detected a concurrent modification so retry (attempt = %d)", attempt)
+
+			select {
+			case <-ctx.Done():
+				return diag.FromErr(ctx.Err())
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+		}
+
+		var desired []string
+
+		if member {
+			desired = append(members, userId)
+		} else {
+			desired = subtractStrings(members, []string{userId})
+		}
+
+		// usergroups.users.update doesn't accept an empty list
+		if len(desired) == 0 {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Slack provider left %s in the slack usergroup (%s)", userId, usergroupId),
+					Detail:   "The user is the last member and a usergroup cannot be empty. Please disable the usergroup if needed.",
+				},
+			}
+		}
+
+		if _, err := client.UpdateUserGroupMembersContext(ctx, usergroupId, strings.Join(desired, ",")); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't update members of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.update"),
+				},
+			}
+		}
+
+		logger.trace(ctx, "Updated members of the usergroup")
+	}
+}
@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// createTestUserGroupMembersRoutes serves members of a usergroup that usergroups.users.update can modify
+func createTestUserGroupMembersRoutes(members *[]string) Routes {
+	return Routes{
+		{
+			Path: "/usergroups.users.list",
+			Response: func(r *http.Request) interface{} {
+				return userGroupUsersListResponse{slack.SlackResponse{Ok: true}, *members}
+			},
+		},
+		{
+			Path: "/usergroups.users.update",
+			Response: func(r *http.Request) interface{} {
+				*members = strings.Split(r.FormValue("users"), ",")
+				return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: "S0001", Users: *members}}
+			},
+		},
+	}
+}
+
+func Test_ResourceUserGroupMemberCreate(t *testing.T) {
+	members := []string{"U0001", "U0002"}
+
+	d := resourceSlackUserGroupMember().TestResourceData()
+	_ = d.Set("usergroup_id", "S0001")
+	_ = d.Set("user_id", "U0003")
+
+	ctx, team := createTestTeam(t, createTestUserGroupMembersRoutes(&members))
+
+	if diags := resourceSlackUserGroupMemberCreate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if d.Id() != "S0001:U0003" {
+		t.Fatalf("expect id to be S0001:U0003, but got %s", d.Id())
+	}
+
+	if len(members) != 3 || !containsAny(members, "U0001") || !containsAny(members, "U0003") {
+		t.Fatalf("expect the user to be added without removing others, but got %v", members)
+	}
+}
+
+func Test_ResourceUserGroupMemberDelete(t *testing.T) {
+	cases := []struct {
+		Members       []string
+		ExpectMembers []string
+		ExpectWarning bool
+	}{
+		{
+			Members:       []string{"U0001", "U0003"},
+			ExpectMembers: []string{"U0001"},
+		},
+		{
+			Members:       []string{"U0003"},
+			ExpectMembers: []string{"U0003"},
+			ExpectWarning: true,
+		},
+	}
+
+	for _, tc := range cases {
+		members := tc.Members
+
+		d := resourceSlackUserGroupMember().TestResourceData()
+		d.SetId("S0001:U0003")
+		_ = d.Set("usergroup_id", "S0001")
+		_ = d.Set("user_id", "U0003")
+
+		ctx, team := createTestTeam(t, createTestUserGroupMembersRoutes(&members))
+
+		diags := resourceSlackUserGroupMemberDelete(ctx, d, team)
+
+		if diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if (len(diags) > 0) != tc.ExpectWarning {
+			t.Fatalf("%v: expect a warning to be %t, but got %v", tc.Members, tc.ExpectWarning, diags)
+		}
+
+		if strings.Join(members, ",") != strings.Join(tc.ExpectMembers, ",") {
+			t.Fatalf("expect members to be %v, but got %v", tc.ExpectMembers, members)
+		}
+
+		if d.Id() != "" {
+			t.Fatalf("expect id to be empty, but got %s", d.Id())
+		}
+	}
+}
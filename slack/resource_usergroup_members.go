@@ -3,12 +3,57 @@ package slack
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/slack-go/slack"
 	"strings"
+	"time"
 )
 
+const (
+	userGroupMembersOnDestroyDisable     = "disable"
+	userGroupMembersOnDestroyKeep        = "keep"
+	userGroupMembersOnDestroyPlaceholder = "placeholder"
+
+	userGroupMembersDeactivatedError = "error"
+	userGroupMembersDeactivatedWarn  = "warn"
+	userGroupMembersDeactivatedPrune = "prune"
+
+	userGroupMembersCacheFileNameFormat  = "usergroup_users_%s.json"
+	userGroupIncludesCacheFileNameFormat = "usergroup_includes_%s.json"
+)
+
+// validateUserGroupMembersOnDestroyValue warns in plans while on_destroy is disable.
+// on_destroy uses DefaultFunc because Terraform doesn't validate values of Default
+func validateUserGroupMembersOnDestroyValue(v interface{}, path cty.Path) diag.Diagnostics {
+	diags := validateEnums([]string{
+		userGroupMembersOnDestroyDisable,
+		userGroupMembersOnDestroyKeep,
+		userGroupMembersOnDestroyPlaceholder,
+	})(v, path)
+
+	if diags.HasError() || v.(string) != userGroupMembersOnDestroyDisable {
+		return diags
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       "Destroying slack_usergroup_members will disable the usergroup",
+			Detail:        fmt.Sprintf("Mentions to the usergroup won't work after destroy. Please set on_destroy to %s or %s if the usergroup should stay enabled.", userGroupMembersOnDestroyKeep, userGroupMembersOnDestroyPlaceholder),
+			AttributePath: path,
+		},
+	}
+}
+
+var validateUserGroupMembersDeactivatedValue = validation.StringInSlice([]string{
+	userGroupMembersDeactivatedError,
+	userGroupMembersDeactivatedWarn,
+	userGroupMembersDeactivatedPrune,
+}, false)
+
 func resourceSlackUserGroupMembers() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   resourceSlackUserGroupMembersRead,
@@ -23,6 +68,13 @@ func resourceSlackUserGroupMembers() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"usergroup_id": {
 				Type:     schema.TypeString,
@@ -33,15 +85,361 @@ func resourceSlackUserGroupMembers() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Required: true,
+				Optional:     true,
+				AtLeastOneOf: []string{"members", "member_emails", "include_usergroups"},
+			},
+			"member_emails": {
+				Type:        schema.TypeSet,
+				Description: "Emails of members. Merged into members",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:     true,
+				AtLeastOneOf: []string{"members", "member_emails", "include_usergroups"},
+			},
+			"include_usergroups": {
+				Type:        schema.TypeSet,
+				Description: "Usergroup ids whose current members are merged into members on apply. Plans fail if the usergroup includes itself or a cycle is found among slack_usergroup_members that have just been refreshed or planned",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:     true,
+				AtLeastOneOf: []string{"members", "member_emails", "include_usergroups"},
+			},
+			"deactivated_members": {
+				Type:         schema.TypeString,
+				Description:  "Either of error, warn or prune. Deactivated users and guests cannot be members so warn and prune don't submit them",
+				Optional:     true,
+				Default:      userGroupMembersDeactivatedError,
+				ValidateFunc: validateUserGroupMembersDeactivatedValue,
+			},
+			"on_destroy": {
+				Type:        schema.TypeString,
+				Description: "Either of disable, keep or placeholder. disable disables the whole usergroup because a usergroup cannot be empty",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return userGroupMembersOnDestroyDisable, nil
+				},
+				ValidateDiagFunc: validateUserGroupMembersOnDestroyValue,
+			},
+			"placeholder_user_id": {
+				Type:        schema.TypeString,
+				Description: "A user who is left in the usergroup on destroy. Required if on_destroy is placeholder",
+				Optional:    true,
+			},
+		},
+
+		CustomizeDiff: customizeDiffSlackUserGroupMembers,
+	}
+}
+
+func customizeDiffSlackUserGroupMembers(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("on_destroy").(string) == userGroupMembersOnDestroyPlaceholder && d.Get("placeholder_user_id").(string) == "" {
+		return fmt.Errorf("placeholder_user_id is required if on_destroy is %s", userGroupMembersOnDestroyPlaceholder)
+	}
+
+	if d.NewValueKnown("usergroup_id") && d.NewValueKnown("include_usergroups") {
+		usergroupId := d.Get("usergroup_id").(string)
+		includes := schemaSetToStrings(d.Get("include_usergroups").(*schema.Set))
+
+		if containsAny(includes, usergroupId) {
+			return fmt.Errorf("include_usergroups cannot contain the usergroup itself (%s)", usergroupId)
+		}
+
+		saveCacheAsJson(fmt.Sprintf(userGroupIncludesCacheFileNameFormat, usergroupId), &includes)
+
+		if cycle := findUserGroupIncludeCycle(usergroupId); len(cycle) > 0 {
+			return fmt.Errorf("include_usergroups cannot be circular: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	var userIds []string
+
+	// Fail the plan instead of the apply if some emails are unknown
+	if d.HasChange("member_emails") && d.NewValueKnown("member_emails") {
+		emails := schemaSetToStrings(d.Get("member_emails").(*schema.Set))
+
+		idsByEmail, unknown, err := lookupUserIdsByEmails(ctx, meta.(*Team), emails)
+
+		if err != nil {
+			return fmt.Errorf("couldn't look up member_emails due to %s", err.Error())
+		}
+
+		if len(unknown) > 0 {
+			return fmt.Errorf("no user has the following emails: %s", strings.Join(unknown, ", "))
+		}
+
+		userIds = append(userIds, mapValues(idsByEmail)...)
+	}
+
+	if d.HasChange("members") && d.NewValueKnown("members") {
+		userIds = append(userIds, schemaSetToStrings(d.Get("members").(*schema.Set))...)
+	}
+
+	if d.Get("deactivated_members").(string) == userGroupMembersDeactivatedError && len(userIds) > 0 {
+		users, err := getUsersWithCache(ctx, meta.(*Team).client)
+
+		if err != nil {
+			return fmt.Errorf("couldn't read users due to %s", err.Error())
+		}
+
+		if inactive := findUsersUnavailableForUserGroups(users, userIds); len(inactive) > 0 {
+			return fmt.Errorf("deactivated users and guests cannot be members of a usergroup. Please remove %s or set deactivated_members to %s or %s", strings.Join(inactive, ", "), userGroupMembersDeactivatedWarn, userGroupMembersDeactivatedPrune)
+		}
+	}
+
+	return nil
+}
+
+func findUsersUnavailableForUserGroups(users []slack.User, userIds []string) []string {
+	var unavailable []string
+
+	for _, user := range users {
+		if (user.Deleted || user.IsRestricted) && containsAny(userIds, user.ID) {
+			unavailable = append(unavailable, user.ID)
+		}
+	}
+
+	return unavailable
+}
+
+// pruneSlackUserGroupMembers drops deactivated users and guests from members to submit according to deactivated_members
+func pruneSlackUserGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, userIds []string) ([]string, []string, diag.Diagnostics) {
+	mode := d.Get("deactivated_members").(string)
+
+	// Plans have already failed in error mode, which is the default, so only warn and prune drop members
+	if mode != userGroupMembersDeactivatedWarn && mode != userGroupMembersDeactivatedPrune {
+		return userIds, nil, nil
+	}
+
+	users, err := getUsersWithCache(ctx, meta.(*Team).client)
+
+	if err != nil {
+		return nil, nil, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't read users due to *%s*", err.Error()),
+				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.list"),
 			},
+		}
+	}
+
+	inactive := findUsersUnavailableForUserGroups(users, userIds)
+
+	if len(inactive) == 0 {
+		return userIds, nil, nil
+	}
+
+	// usergroups.users.update rejects an empty list with an unclear error
+	if len(subtractStrings(userIds, inactive)) == 0 {
+		return nil, nil, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't submit members of the slack usergroup (%s) because all of them are deactivated users or guests", d.Get("usergroup_id").(string)),
+				Detail:   fmt.Sprintf("A usergroup cannot be empty. Please add active members or destroy this resource to apply on_destroy (%s).", d.Get("on_destroy").(string)),
+			},
+		}
+	}
+
+	if mode == userGroupMembersDeactivatedPrune {
+		return subtractStrings(userIds, inactive), inactive, nil
+	}
+
+	return subtractStrings(userIds, inactive), inactive, diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Slack provider didn't submit %d deactivated users or guests as members of the slack usergroup (%s)", len(inactive), d.Get("usergroup_id").(string)),
+			Detail:   fmt.Sprintf("Please remove %s from members", strings.Join(inactive, ", ")),
 		},
 	}
 }
 
-func configureSlackUserGroupMembers(ctx context.Context, logger *Logger, d *schema.ResourceData, userGroup slack.UserGroup) {
+// userGroupMemberSources remembers where users come from so that they can be attributed to arguments
+type userGroupMemberSources struct {
+	idsByEmail     map[string]string
+	idsByUserGroup map[string][]string
+}
+
+// findUserGroupIncludeCycle walks include_usergroups that have been cached by refreshes and plans of slack_usergroup_members.
+// The cache is short-lived so only cycles among resources in the same run are found
+func findUserGroupIncludeCycle(rootId string) []string {
+	var walk func(path []string) []string
+
+	walk = func(path []string) []string {
+		var includes []string
+
+		if !restoreJsonCache(fmt.Sprintf(userGroupIncludesCacheFileNameFormat, path[len(path)-1]), &includes) {
+			return nil
+		}
+
+		for _, id := range includes {
+			if id == rootId {
+				return append(path, id)
+			}
+
+			if containsAny(path, id) {
+				continue
+			}
+
+			if cycle := walk(append(path[:len(path):len(path)], id)); cycle != nil {
+				return cycle
+			}
+		}
+
+		return nil
+	}
+
+	return walk([]string{rootId})
+}
+
+// resolveSlackUserGroupMemberSources resolves member_emails and include_usergroups. Unknown emails are returned instead of an error.
+// Members of include_usergroups are read through the cache only if cached is true because applies must see the latest members
+func resolveSlackUserGroupMemberSources(ctx context.Context, d *schema.ResourceData, meta interface{}, cached bool) (userGroupMemberSources, []string, diag.Diagnostics) {
+	var sources userGroupMemberSources
+	var unknown []string
+	var err error
+
+	if emails := schemaSetToStrings(d.Get("member_emails").(*schema.Set)); len(emails) > 0 {
+		sources.idsByEmail, unknown, err = lookupUserIdsByEmails(ctx, meta.(*Team), emails)
+
+		if err != nil {
+			return sources, nil, diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't look up users by member_emails due to *%s*", err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.lookupByEmail"),
+				},
+			}
+		}
+	}
+
+	includes := schemaSetToStrings(d.Get("include_usergroups").(*schema.Set))
+
+	if len(includes) > 0 {
+		sources.idsByUserGroup = map[string][]string{}
+
+		for _, id := range includes {
+			var members []string
+
+			if cached {
+				members, err = getUserGroupMembersWithCache(ctx, meta.(*Team).client, id)
+			} else {
+				members, err = fetchUserGroupMembers(ctx, meta.(*Team).client, id)
+			}
+
+			if err != nil {
+				return sources, nil, diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Slack provider couldn't read members of the included slack usergroup (%s) due to *%s*", id, err.Error()),
+						Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.list"),
+					},
+				}
+			}
+
+			sources.idsByUserGroup[id] = members
+		}
+	}
+
+	return sources, unknown, nil
+}
+
+// expandSlackUserGroupMembers merges members, users of member_emails and members of include_usergroups
+func expandSlackUserGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]string, userGroupMemberSources, diag.Diagnostics) {
+	userIds := schemaSetToStrings(d.Get("members").(*schema.Set))
+
+	sources, unknown, diags := resolveSlackUserGroupMemberSources(ctx, d, meta, false)
+
+	if diags.HasError() {
+		return nil, sources, diags
+	}
+
+	if len(unknown) > 0 {
+		return nil, sources, diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Slack provider couldn't find %d users by member_emails", len(unknown)),
+				Detail:   fmt.Sprintf("No user has the following emails: %s", strings.Join(unknown, ", ")),
+			},
+		}
+	}
+
+	for _, id := range sources.userIds() {
+		if !containsAny(userIds, id) {
+			userIds = append(userIds, id)
+		}
+	}
+
+	return userIds, sources, nil
+}
+
+// getUserGroupMembersWithCache shares members of included usergroups between resources because the rate limit of usergroups.users.list is strict
+func getUserGroupMembersWithCache(ctx context.Context, client *slack.Client, usergroupId string) ([]string, error) {
+	var members []string
+
+	if restoreJsonCache(fmt.Sprintf(userGroupMembersCacheFileNameFormat, usergroupId), &members) {
+		return members, nil
+	}
+
+	return fetchUserGroupMembers(ctx, client, usergroupId)
+}
+
+// fetchUserGroupMembers calls usergroups.users.list and refreshes the cache
+func fetchUserGroupMembers(ctx context.Context, client *slack.Client, usergroupId string) ([]string, error) {
+	members, err := client.GetUserGroupMembersContext(ctx, usergroupId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	saveCacheAsJson(fmt.Sprintf(userGroupMembersCacheFileNameFormat, usergroupId), &members)
+
+	return members, nil
+}
+
+func (s userGroupMemberSources) userIds() []string {
+	userIds := mapValues(s.idsByEmail)
+
+	for _, members := range s.idsByUserGroup {
+		userIds = append(userIds, members...)
+	}
+
+	return userIds
+}
+
+// configureSlackUserGroupMembers attributes users to member_emails and include_usergroups if they are not listed in members explicitly.
+// An included usergroup is kept only while all of its current members are in the usergroup so that changes of nested usergroups show up as drift
+func configureSlackUserGroupMembers(ctx context.Context, logger *Logger, d *schema.ResourceData, userGroup slack.UserGroup, sources userGroupMemberSources) {
+	explicit := schemaSetToStrings(d.Get("members").(*schema.Set))
+
+	var members []string
+	var emails []string
+	var includes []string
+
+	for email, id := range sources.idsByEmail {
+		if containsAny(userGroup.Users, id) {
+			emails = append(emails, email)
+		}
+	}
+
+	for id, users := range sources.idsByUserGroup {
+		if len(subtractStrings(users, userGroup.Users)) == 0 {
+			includes = append(includes, id)
+		} else {
+			logger.debug(ctx, "Members of the included usergroup (%s) have been changed", id)
+		}
+	}
+
+	for _, id := range userGroup.Users {
+		if containsAny(explicit, id) || !containsAny(sources.userIds(), id) {
+			members = append(members, id)
+		}
+	}
+
 	d.SetId(userGroup.ID)
-	_ = d.Set("members", userGroup.Users)
+	_ = d.Set("members", members)
+	_ = d.Set("member_emails", emails)
+	_ = d.Set("include_usergroups", includes)
 
 	logger.debug(ctx, "Configured channel members")
 }
@@ -57,11 +455,18 @@ func resourceSlackUserGroupMembersCreate(ctx context.Context, d *schema.Resource
 
 	logger.trace(ctx, "Start creating members of the usergroup")
 
-	iMembers := d.Get("members").(*schema.Set)
-	userIds := make([]string, len(iMembers.List()))
-	for i, v := range iMembers.List() {
-		userIds[i] = v.(string)
+	userIds, sources, diags := expandSlackUserGroupMembers(ctx, d, meta)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	userIds, pruned, diags := pruneSlackUserGroupMembers(ctx, d, meta, userIds)
+
+	if diags.HasError() {
+		return diags
 	}
+
 	userIdParam := strings.Join(userIds, ",")
 
 	userGroup, err := client.UpdateUserGroupMembersContext(ctx, usergroupId, userIdParam)
@@ -76,9 +481,12 @@ func resourceSlackUserGroupMembersCreate(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	configureSlackUserGroupMembers(ctx, logger, d, userGroup)
+	// Keep pruned users in the state so that they don't show up as drift
+	userGroup.Users = append(userGroup.Users, pruned...)
 
-	return nil
+	configureSlackUserGroupMembers(ctx, logger, d, userGroup, sources)
+
+	return diags
 }
 
 func resourceSlackUserGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -116,9 +524,36 @@ func resourceSlackUserGroupMembersRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
-	_ = d.Set("members", members)
+	// Refreshes run before plans so that plans can find cycles with usergroups that are planned earlier
+	includes := schemaSetToStrings(d.Get("include_usergroups").(*schema.Set))
+	saveCacheAsJson(fmt.Sprintf(userGroupIncludesCacheFileNameFormat, usergroupId), &includes)
 
-	logger.debug(ctx, "Configured members of the usergroup")
+	// Unknown emails are just missing from the state so they will be shown as drift
+	sources, _, diags := resolveSlackUserGroupMemberSources(ctx, d, meta, true)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.Get("deactivated_members").(string) != userGroupMembersDeactivatedError {
+		users, err := getUsersWithCache(ctx, client)
+
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't read users due to *%s*", err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/users.list"),
+				},
+			}
+		}
+
+		// Pruned users are never members so keep them in the state
+		known := append(schemaSetToStrings(d.Get("members").(*schema.Set)), sources.userIds()...)
+		members = append(members, subtractStrings(findUsersUnavailableForUserGroups(users, known), members)...)
+	}
+
+	configureSlackUserGroupMembers(ctx, logger, d, slack.UserGroup{ID: usergroupId, Users: members}, sources)
 
 	return nil
 }
@@ -146,6 +581,18 @@ func resourceSlackUserGroupMembersUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	userIds, sources, diags := expandSlackUserGroupMembers(ctx, d, meta)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	userIds, pruned, diags := pruneSlackUserGroupMembers(ctx, d, meta, userIds)
+
+	if diags.HasError() {
+		return diags
+	}
+
 	logger.debug(ctx, "Enable the usergroup first because disabled usergroups reject updates")
 	_, err := client.EnableUserGroupContext(ctx, usergroupId)
 
@@ -159,28 +606,32 @@ func resourceSlackUserGroupMembersUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	iMembers := d.Get("members").(*schema.Set)
-	userIds := make([]string, len(iMembers.List()))
-	for i, v := range iMembers.List() {
-		userIds[i] = v.(string)
-	}
+	// Otherwise this resource and enabled = false of slack_usergroup would undo each other on every apply
+	wasDisabled := err == nil
+
 	userIdParam := strings.Join(userIds, ",")
 
 	userGroup, err := client.UpdateUserGroupMembersContext(ctx, usergroupId, userIdParam)
 
+	if wasDisabled {
+		logger.debug(ctx, "Disable the usergroup again")
+		diags = append(diags, setSlackUserGroupEnabled(ctx, logger, client, usergroupId, false)...)
+	}
+
 	if err != nil {
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Slack provider couldn't update members of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
-				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.update"),
-			},
-		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Slack provider couldn't update members of the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+			Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.update"),
+		})
 	}
 
-	configureSlackUserGroupMembers(ctx, logger, d, userGroup)
+	// Keep pruned users in the state so that they don't show up as drift
+	userGroup.Users = append(userGroup.Users, pruned...)
 
-	return nil
+	configureSlackUserGroupMembers(ctx, logger, d, userGroup, sources)
+
+	return diags
 }
 
 func resourceSlackUserGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -206,16 +657,35 @@ func resourceSlackUserGroupMembersDelete(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	logger.debug(ctx, "A usergroup that has no members cannot be created by web API so just disable it")
+	switch d.Get("on_destroy").(string) {
+	case userGroupMembersOnDestroyKeep:
+		logger.debug(ctx, "Keep members of the usergroup as they are")
+	case userGroupMembersOnDestroyPlaceholder:
+		placeholder := d.Get("placeholder_user_id").(string)
 
-	// Cannot use "" as a member parameter, so let me disable it
-	if _, err := client.DisableUserGroupContext(ctx, usergroupId); err != nil {
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Slack provider couldn't disable the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
-				Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.disable"),
-			},
+		logger.debug(ctx, "Replace members of the usergroup with the placeholder user (%s)", placeholder)
+
+		if _, err := client.UpdateUserGroupMembersContext(ctx, usergroupId, placeholder); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't replace members of the slack usergroup (%s) with the placeholder user (%s) due to *%s*", usergroupId, placeholder, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.users.update"),
+				},
+			}
+		}
+	default:
+		logger.debug(ctx, "A usergroup that has no members cannot be created by web API so just disable it")
+
+		// Cannot use "" as a member parameter, so let me disable it
+		if _, err := client.DisableUserGroupContext(ctx, usergroupId); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Slack provider couldn't disable the slack usergroup (%s) due to *%s*", usergroupId, err.Error()),
+					Detail:   fmt.Sprintf("Please refer to %s for the details.", "https://api.slack.com/methods/usergroups.disable"),
+				},
+			}
 		}
 	}
 
@@ -1,9 +1,14 @@
 package slack
 
 import (
+	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/slack-go/slack"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -16,16 +21,14 @@ func stringInSlice(slice []string, val string) bool {
 	return false
 }
 
-type userGroupResponse struct {
-	slack.SlackResponse
-	UserGroup slack.UserGroup `json:"usergroup"`
-}
-
 type userGroupUsersListResponse struct {
 	slack.SlackResponse
 	Users []string `json:"users"`
 }
 
+// testUserGroupUsers has a guest in addition to testUsers because guests cannot be members of usergroups
+var testUserGroupUsers = append([]slack.User{{ID: "UGUEST", IsRestricted: true}}, testUsers...)
+
 var testUserGroup = slack.UserGroup{
 	ID:    "S0615G0KT",
 	Users: []string{"U0614TZR7", "U060RNRCZ"},
@@ -33,7 +36,15 @@ var testUserGroup = slack.UserGroup{
 
 func Test_ResourceUserGroupMembersRead(t *testing.T) {
 	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
 	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
 		{
 			Path: "/usergroups.users.list",
 			Response: userGroupUsersListResponse{
@@ -64,6 +75,7 @@ func Test_ResourceUserGroupMembersRead(t *testing.T) {
 
 func Test_ResourceUserGroupMembersCreate(t *testing.T) {
 	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
 
 	newMembers := &schema.Set{F: schema.HashString}
 	for _, u := range testUserGroup.Users {
@@ -74,6 +86,13 @@ func Test_ResourceUserGroupMembersCreate(t *testing.T) {
 	}
 
 	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
 		{
 			Path: "/usergroups.users.update",
 			Response: userGroupResponse{
@@ -104,6 +123,7 @@ func Test_ResourceUserGroupMembersCreate(t *testing.T) {
 
 func Test_ResourceUserGroupMembersUpdate(t *testing.T) {
 	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
 	d.SetId(testUserGroup.ID)
 	if err := d.Set("usergroup_id", testUserGroup.ID); err != nil {
 		t.Fatalf("err set usergroup_id: %s", err)
@@ -120,10 +140,21 @@ func Test_ResourceUserGroupMembersUpdate(t *testing.T) {
 	newTestUserGroup.Users = append(newTestUserGroup.Users, "NUSERID")
 
 	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
 		{
 			Path:     "/usergroups.enable",
 			Response: slack.SlackResponse{Ok: true},
 		},
+		{
+			Path:     "/usergroups.disable",
+			Response: slack.SlackResponse{Ok: true},
+		},
 		{
 			Path: "/usergroups.users.update",
 			Response: userGroupResponse{
@@ -154,6 +185,7 @@ func Test_ResourceUserGroupMembersUpdate(t *testing.T) {
 
 func Test_ResourceUserGroupMembersDelete(t *testing.T) {
 	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
 	d.SetId(testUserGroup.ID)
 	if err := d.Set("usergroup_id", testUserGroup.ID); err != nil {
 		t.Fatalf("err set usergroup_id: %s", err)
@@ -182,3 +214,534 @@ func Test_ResourceUserGroupMembersDelete(t *testing.T) {
 	}
 
 }
+
+func Test_ResourceUserGroupMembersReadWithExpiredContext(t *testing.T) {
+	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.users.list",
+			Response: userGroupUsersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroup.Users,
+			},
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 0)
+	defer cancel()
+
+	if diags := resourceSlackUserGroupMembersRead(ctx, d, team); !diags.HasError() {
+		t.Fatalf("expect the expired context to abort the request")
+	}
+}
+
+func Test_ResourceUserGroupMembersDeleteWithOnDestroy(t *testing.T) {
+	cases := []struct {
+		OnDestroy   string
+		Placeholder string
+		Routes      Routes
+	}{
+		{
+			OnDestroy: userGroupMembersOnDestroyKeep,
+			Routes:    Routes{},
+		},
+		{
+			OnDestroy:   userGroupMembersOnDestroyPlaceholder,
+			Placeholder: "UPLACEHOLDER",
+			Routes: Routes{
+				{
+					Path: "/usergroups.users.update",
+					Response: userGroupResponse{
+						slack.SlackResponse{Ok: true},
+						slack.UserGroup{ID: testUserGroup.ID, Users: []string{"UPLACEHOLDER"}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackUserGroupMembers().TestResourceData()
+		_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+		d.SetId(testUserGroup.ID)
+		_ = d.Set("usergroup_id", testUserGroup.ID)
+		_ = d.Set("on_destroy", tc.OnDestroy)
+		_ = d.Set("placeholder_user_id", tc.Placeholder)
+
+		ctx, team := createTestTeam(t, tc.Routes)
+
+		if diags := resourceSlackUserGroupMembersDelete(ctx, d, team); diags.HasError() {
+			t.Fatalf("%s: err: %s", tc.OnDestroy, diags[0].Summary)
+		}
+
+		if d.Id() != "" {
+			t.Fatalf("%s: expect id to be empty, but got %s", tc.OnDestroy, d.Id())
+		}
+	}
+}
+
+func createTestLookupByEmailRoute(idsByEmail map[string]string) Route {
+	return Route{
+		Path: "/users.lookupByEmail",
+		Response: func(r *http.Request) interface{} {
+			if id, ok := idsByEmail[r.FormValue("email")]; ok {
+				return userResponse{slack.SlackResponse{Ok: true}, slack.User{ID: id}}
+			}
+
+			return slack.SlackResponse{Ok: false, Error: "users_not_found"}
+		},
+	}
+}
+
+type userResponse struct {
+	slack.SlackResponse
+	User slack.User `json:"user"`
+}
+
+func Test_ResourceUserGroupMembersCreateWithEmails(t *testing.T) {
+	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+	_ = d.Set("usergroup_id", testUserGroup.ID)
+	_ = d.Set("members", []string{"U0614TZR7"})
+	_ = d.Set("member_emails", []string{"alice@example.com"})
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
+		createTestLookupByEmailRoute(map[string]string{"alice@example.com": "U060RNRCZ"}),
+		{
+			Path: "/usergroups.users.update",
+			Response: func(r *http.Request) interface{} {
+				return userGroupResponse{
+					slack.SlackResponse{Ok: true},
+					slack.UserGroup{ID: testUserGroup.ID, Users: strings.Split(r.FormValue("users"), ",")},
+				}
+			},
+		},
+	})
+
+	if diags := resourceSlackUserGroupMembersCreate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if members := d.Get("members").(*schema.Set); members.Len() != 1 || !members.Contains("U0614TZR7") {
+		t.Fatalf("expect members to keep only explicit members, but got %v", members.List())
+	}
+
+	if emails := d.Get("member_emails").(*schema.Set); emails.Len() != 1 || !emails.Contains("alice@example.com") {
+		t.Fatalf("expect member_emails to be kept, but got %v", emails.List())
+	}
+}
+
+func Test_ResourceUserGroupMembersCreateWithUnknownEmails(t *testing.T) {
+	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+	_ = d.Set("usergroup_id", testUserGroup.ID)
+	_ = d.Set("member_emails", []string{"alice@example.com", "bob@example.com", "carol@example.com"})
+
+	ctx, team := createTestTeam(t, Routes{
+		createTestLookupByEmailRoute(map[string]string{"alice@example.com": "U060RNRCZ"}),
+	})
+
+	diags := resourceSlackUserGroupMembersCreate(ctx, d, team)
+
+	if !diags.HasError() {
+		t.Fatalf("expect unknown emails to be an error")
+	}
+
+	if detail := diags[0].Detail; !strings.Contains(detail, "bob@example.com, carol@example.com") {
+		t.Fatalf("expect all unknown emails to be reported, but got %s", detail)
+	}
+}
+
+func Test_ResourceUserGroupMembersCreateWithDeactivatedMembers(t *testing.T) {
+	cases := []struct {
+		Mode          string
+		ExpectWarning bool
+	}{
+		{
+			Mode:          userGroupMembersDeactivatedWarn,
+			ExpectWarning: true,
+		},
+		{
+			Mode:          userGroupMembersDeactivatedPrune,
+			ExpectWarning: false,
+		},
+	}
+
+	for _, tc := range cases {
+		var submitted string
+
+		d := resourceSlackUserGroupMembers().TestResourceData()
+		_ = d.Set("usergroup_id", testUserGroup.ID)
+		_ = d.Set("members", []string{"U0614TZR7", "UDEACTIVE", "UGUEST"})
+		_ = d.Set("deactivated_members", tc.Mode)
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/users.list",
+				Response: usersListResponse{
+					slack.SlackResponse{Ok: true},
+					testUserGroupUsers,
+				},
+			},
+			{
+				Path: "/usergroups.users.update",
+				Response: func(r *http.Request) interface{} {
+					submitted = r.FormValue("users")
+					return userGroupResponse{
+						slack.SlackResponse{Ok: true},
+						slack.UserGroup{ID: testUserGroup.ID, Users: strings.Split(submitted, ",")},
+					}
+				},
+			},
+		})
+
+		diags := resourceSlackUserGroupMembersCreate(ctx, d, team)
+
+		if diags.HasError() {
+			t.Fatalf("%s: err: %s", tc.Mode, diags[0].Summary)
+		}
+
+		if (len(diags) > 0) != tc.ExpectWarning {
+			t.Fatalf("%s: expect a warning to be %t, but got %v", tc.Mode, tc.ExpectWarning, diags)
+		}
+
+		if submitted != "U0614TZR7" {
+			t.Fatalf("%s: expect only active users to be submitted, but got %s", tc.Mode, submitted)
+		}
+
+		if members := d.Get("members").(*schema.Set); members.Len() != 3 {
+			t.Fatalf("%s: expect pruned users to be kept in the state, but got %v", tc.Mode, members.List())
+		}
+	}
+}
+
+func Test_ResourceUserGroupMembersCreateWithOnlyDeactivatedMembers(t *testing.T) {
+	submitted := false
+
+	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("usergroup_id", testUserGroup.ID)
+	_ = d.Set("members", []string{"UDEACTIVE", "UGUEST"})
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedPrune)
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
+		{
+			Path: "/usergroups.users.update",
+			Response: func(r *http.Request) interface{} {
+				submitted = true
+				return slack.SlackResponse{Ok: false, Error: "invalid_users"}
+			},
+		},
+	})
+
+	diags := resourceSlackUserGroupMembersCreate(ctx, d, team)
+
+	if !diags.HasError() {
+		t.Fatalf("expect an error if no member is left")
+	}
+
+	if !strings.Contains(diags[0].Summary, "all of them are deactivated users or guests") {
+		t.Fatalf("expect a clear error, but got %s", diags[0].Summary)
+	}
+
+	if submitted {
+		t.Fatalf("expect an empty list not to be submitted")
+	}
+}
+
+func Test_ResourceUserGroupMembersPlanWithDeactivatedMembers(t *testing.T) {
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
+	})
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"usergroup_id": testUserGroup.ID,
+		"members":      []interface{}{"U0614TZR7", "UDEACTIVE"},
+	})
+
+	if _, err := resourceSlackUserGroupMembers().Diff(ctx, nil, config, team); err == nil || !strings.Contains(err.Error(), "UDEACTIVE") {
+		t.Fatalf("expect the plan to fail with the deactivated user, but got %v", err)
+	}
+}
+
+func createTestUserGroupUsersListRoute(membersByUserGroup map[string][]string) Route {
+	return Route{
+		Path: "/usergroups.users.list",
+		Response: func(r *http.Request) interface{} {
+			return userGroupUsersListResponse{slack.SlackResponse{Ok: true}, membersByUserGroup[r.FormValue("usergroup")]}
+		},
+	}
+}
+
+func Test_ResourceUserGroupMembersCreateWithIncludedUserGroups(t *testing.T) {
+	var submitted []string
+
+	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+	_ = d.Set("usergroup_id", "S0001")
+	_ = d.Set("members", []string{"U0003"})
+	_ = d.Set("include_usergroups", []string{"S0002"})
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
+		createTestUserGroupUsersListRoute(map[string][]string{"S0002": {"U0001", "U0002"}}),
+		{
+			Path: "/usergroups.users.update",
+			Response: func(r *http.Request) interface{} {
+				submitted = strings.Split(r.FormValue("users"), ",")
+				return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: "S0001", Users: submitted}}
+			},
+		},
+	})
+
+	if diags := resourceSlackUserGroupMembersCreate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if len(submitted) != 3 {
+		t.Fatalf("expect members of the included usergroup to be submitted, but got %v", submitted)
+	}
+
+	if members := d.Get("members").(*schema.Set); members.Len() != 1 || !members.Contains("U0003") {
+		t.Fatalf("expect members to keep only explicit members, but got %v", members.List())
+	}
+
+	if includes := d.Get("include_usergroups").(*schema.Set); includes.Len() != 1 {
+		t.Fatalf("expect include_usergroups to be kept, but got %v", includes.List())
+	}
+}
+
+func Test_ResourceUserGroupMembersReadWithChangedIncludedUserGroups(t *testing.T) {
+	d := resourceSlackUserGroupMembers().TestResourceData()
+	_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+	d.SetId("S0001")
+	_ = d.Set("usergroup_id", "S0001")
+	_ = d.Set("members", []string{"U0003"})
+	_ = d.Set("include_usergroups", []string{"S0002"})
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/users.list",
+			Response: usersListResponse{
+				slack.SlackResponse{Ok: true},
+				testUserGroupUsers,
+			},
+		},
+		createTestUserGroupUsersListRoute(map[string][]string{
+			"S0001": {"U0001", "U0002", "U0003"},
+			"S0002": {"U0002", "U0004"},
+		}),
+	})
+
+	if diags := resourceSlackUserGroupMembersRead(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if includes := d.Get("include_usergroups").(*schema.Set); includes.Len() != 0 {
+		t.Fatalf("expect the changed usergroup to show up as drift, but got %v", includes.List())
+	}
+
+	if members := d.Get("members").(*schema.Set); members.Len() != 2 || !members.Contains("U0001") {
+		t.Fatalf("expect the user who left the included usergroup to show up as drift, but got %v", members.List())
+	}
+}
+
+func Test_ResourceUserGroupMembersReadSharesIncludedUserGroups(t *testing.T) {
+	calls := map[string]int{}
+	membersByUserGroup := map[string][]string{
+		"S0001": {"U0002", "U0004"},
+		"S0002": {"U0002", "U0004"},
+		"S0003": {"U0002", "U0004"},
+	}
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.users.list",
+			Response: func(r *http.Request) interface{} {
+				calls[r.FormValue("usergroup")]++
+				return userGroupUsersListResponse{slack.SlackResponse{Ok: true}, membersByUserGroup[r.FormValue("usergroup")]}
+			},
+		},
+	})
+
+	for _, usergroupId := range []string{"S0001", "S0003"} {
+		d := resourceSlackUserGroupMembers().TestResourceData()
+		d.SetId(usergroupId)
+		_ = d.Set("usergroup_id", usergroupId)
+		_ = d.Set("include_usergroups", []string{"S0002"})
+		_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+
+		if diags := resourceSlackUserGroupMembersRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+	}
+
+	if calls["S0002"] != 1 {
+		t.Fatalf("expect members of the included usergroup to be read once, but got %d", calls["S0002"])
+	}
+}
+
+func Test_ResourceUserGroupMembersUpdateKeepsDisabledUserGroup(t *testing.T) {
+	cases := []struct {
+		EnableError string
+		ExpectCalls []string
+	}{
+		{
+			// The usergroup was disabled
+			EnableError: "",
+			ExpectCalls: []string{"/usergroups.enable", "/usergroups.users.update", "/usergroups.disable"},
+		},
+		{
+			EnableError: "already_enabled",
+			ExpectCalls: []string{"/usergroups.enable", "/usergroups.users.update"},
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackUserGroupMembers().TestResourceData()
+		d.SetId(testUserGroup.ID)
+		_ = d.Set("usergroup_id", testUserGroup.ID)
+		_ = d.Set("members", testUserGroup.Users)
+		_ = d.Set("deactivated_members", userGroupMembersDeactivatedError)
+
+		var calls []string
+		enableError := tc.EnableError
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/usergroups.enable",
+				Response: func(r *http.Request) interface{} {
+					calls = append(calls, r.URL.Path)
+					return slack.SlackResponse{Ok: enableError == "", Error: enableError}
+				},
+			},
+			{
+				Path: "/usergroups.disable",
+				Response: func(r *http.Request) interface{} {
+					calls = append(calls, r.URL.Path)
+					return slack.SlackResponse{Ok: true}
+				},
+			},
+			{
+				Path: "/usergroups.users.update",
+				Response: func(r *http.Request) interface{} {
+					calls = append(calls, r.URL.Path)
+					return userGroupResponse{
+						slack.SlackResponse{Ok: true},
+						testUserGroup,
+					}
+				},
+			},
+		})
+
+		if diags := resourceSlackUserGroupMembersUpdate(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if strings.Join(calls, ",") != strings.Join(tc.ExpectCalls, ",") {
+			t.Fatalf("expect %v to be called, but got %v", tc.ExpectCalls, calls)
+		}
+	}
+}
+
+func Test_ResourceUserGroupMembersValidateOnDestroy(t *testing.T) {
+	cases := []struct {
+		Raw           map[string]interface{}
+		ExpectWarning bool
+	}{
+		{
+			// disable by default
+			Raw:           map[string]interface{}{},
+			ExpectWarning: true,
+		},
+		{
+			Raw: map[string]interface{}{
+				"on_destroy": userGroupMembersOnDestroyDisable,
+			},
+			ExpectWarning: true,
+		},
+		{
+			Raw: map[string]interface{}{
+				"on_destroy": userGroupMembersOnDestroyKeep,
+			},
+			ExpectWarning: false,
+		},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"usergroup_id": testUserGroup.ID,
+			"members":      []interface{}{"U0614TZR7"},
+		}
+
+		for k, v := range tc.Raw {
+			raw[k] = v
+		}
+
+		diags := resourceSlackUserGroupMembers().Validate(terraform.NewResourceConfigRaw(raw))
+
+		if diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if tc.ExpectWarning != (len(diags) > 0) {
+			t.Fatalf("expect a warning in plans to be %t with %v, but got %v", tc.ExpectWarning, tc.Raw, diags)
+		}
+	}
+}
+
+func Test_ResourceUserGroupMembersPlanWithSelfInclusion(t *testing.T) {
+	ctx, team := createTestTeam(t, Routes{})
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"usergroup_id":       "S0001",
+		"include_usergroups": []interface{}{"S0002", "S0001"},
+	})
+
+	if _, err := resourceSlackUserGroupMembers().Diff(ctx, nil, config, team); err == nil || !strings.Contains(err.Error(), "itself (S0001)") {
+		t.Fatalf("expect the plan to fail with the self inclusion, but got %v", err)
+	}
+}
+
+func Test_ResourceUserGroupMembersPlanWithCircularIncludes(t *testing.T) {
+	ctx, team := createTestTeam(t, Routes{})
+
+	// S0002 and S0003 have just been refreshed or planned
+	saveCacheAsJson(fmt.Sprintf(userGroupIncludesCacheFileNameFormat, "S0002"), &[]string{"S0003"})
+	saveCacheAsJson(fmt.Sprintf(userGroupIncludesCacheFileNameFormat, "S0003"), &[]string{"S0001"})
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"usergroup_id":       "S0001",
+		"include_usergroups": []interface{}{"S0002"},
+	})
+
+	if _, err := resourceSlackUserGroupMembers().Diff(ctx, nil, config, team); err == nil || !strings.Contains(err.Error(), "S0001 -> S0002 -> S0003 -> S0001") {
+		t.Fatalf("expect the plan to fail with the cycle, but got %v", err)
+	}
+}
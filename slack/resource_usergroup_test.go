@@ -0,0 +1,249 @@
+package slack
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"net/http"
+	"testing"
+)
+
+func Test_ResourceUserGroupRead(t *testing.T) {
+	cases := []struct {
+		Id            string
+		ExpectEnabled bool
+	}{
+		{
+			Id:            "S0001",
+			ExpectEnabled: true,
+		},
+		{
+			Id:            "S0003",
+			ExpectEnabled: false,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackUserGroup().TestResourceData()
+		d.SetId(tc.Id)
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path: "/usergroups.list",
+				Response: userGroupsListResponse{
+					slack.SlackResponse{Ok: true},
+					testUserGroups,
+				},
+			},
+		})
+
+		if diags := resourceSlackUserGroupRead(ctx, d, team); diags.HasError() {
+			t.Fatalf("err: %s", diags[0].Summary)
+		}
+
+		if enabled := d.Get("enabled").(bool); enabled != tc.ExpectEnabled {
+			t.Fatalf("%s: expect enabled to be %t, but got %t", tc.Id, tc.ExpectEnabled, enabled)
+		}
+	}
+}
+
+func Test_ResourceUserGroupUpdateToEnable(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSlackUserGroup().Schema, map[string]interface{}{
+		"handle":  "legacy",
+		"enabled": true,
+	})
+	d.SetId("S0003")
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.enable",
+			Response: userGroupResponse{
+				slack.SlackResponse{Ok: true},
+				slack.UserGroup{ID: "S0003", Handle: "legacy"},
+			},
+		},
+		{
+			Path: "/usergroups.update",
+			Response: userGroupResponse{
+				slack.SlackResponse{Ok: true},
+				slack.UserGroup{ID: "S0003", Handle: "legacy", Name: "legacy"},
+			},
+		},
+	})
+
+	if diags := resourceSlackUserGroupUpdate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	if !d.Get("enabled").(bool) {
+		t.Fatalf("expect the usergroup to be enabled")
+	}
+}
+
+func Test_ResourceUserGroupCreateAdoptingDisabled(t *testing.T) {
+	cases := []struct {
+		Handle        string
+		Name          string
+		CreateError   string
+		AdoptDisabled bool
+		UpdateError   string
+		ExpectId      string
+		ExpectEnabled []string
+		ExpectDisable []string
+	}{
+		{
+			Handle:        "legacy",
+			CreateError:   "handle_already_exists",
+			AdoptDisabled: true,
+			ExpectId:      "S0003",
+			ExpectEnabled: []string{"S0003"},
+		},
+		{
+			Handle:        "legacy",
+			CreateError:   "handle_already_exists",
+			AdoptDisabled: false,
+			ExpectId:      "",
+		},
+		{
+			// S0001 is enabled and owns the handle so S0002 must stay disabled
+			Handle:        "sre-oncall",
+			CreateError:   "handle_already_exists",
+			AdoptDisabled: true,
+			ExpectId:      "",
+		},
+		{
+			Handle:        "new-legacy",
+			Name:          "Legacy",
+			CreateError:   "name_already_exists",
+			AdoptDisabled: true,
+			ExpectId:      "S0003",
+			ExpectEnabled: []string{"S0003"},
+		},
+		{
+			Handle:        "legacy",
+			CreateError:   "handle_already_exists",
+			AdoptDisabled: true,
+			UpdateError:   "invalid_name",
+			ExpectId:      "",
+			ExpectEnabled: []string{"S0003"},
+			ExpectDisable: []string{"S0003"},
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceSlackUserGroup().TestResourceData()
+		_ = d.Set("handle", tc.Handle)
+		_ = d.Set("name", tc.Name)
+		_ = d.Set("adopt_disabled", tc.AdoptDisabled)
+
+		var enabled []string
+		var disabled []string
+		updateError := tc.UpdateError
+
+		ctx, team := createTestTeam(t, Routes{
+			{
+				Path:     "/usergroups.create",
+				Response: slack.SlackResponse{Ok: false, Error: tc.CreateError},
+			},
+			{
+				Path: "/usergroups.list",
+				Response: userGroupsListResponse{
+					slack.SlackResponse{Ok: true},
+					testUserGroups,
+				},
+			},
+			{
+				Path: "/usergroups.enable",
+				Response: func(r *http.Request) interface{} {
+					enabled = append(enabled, r.FormValue("usergroup"))
+					return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: r.FormValue("usergroup")}}
+				},
+			},
+			{
+				Path: "/usergroups.disable",
+				Response: func(r *http.Request) interface{} {
+					disabled = append(disabled, r.FormValue("usergroup"))
+					return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: r.FormValue("usergroup")}}
+				},
+			},
+			{
+				Path: "/usergroups.update",
+				Response: func(r *http.Request) interface{} {
+					if updateError != "" {
+						return slack.SlackResponse{Ok: false, Error: updateError}
+					}
+
+					return userGroupResponse{
+						slack.SlackResponse{Ok: true},
+						slack.UserGroup{ID: r.FormValue("usergroup"), Handle: r.FormValue("handle"), Name: r.FormValue("name")},
+					}
+				},
+			},
+		})
+
+		diags := resourceSlackUserGroupCreate(ctx, d, team)
+
+		if diags.HasError() != (tc.ExpectId == "") {
+			t.Fatalf("@%s: unexpected diagnostics %v", tc.Handle, diags)
+		}
+
+		if d.Id() != tc.ExpectId {
+			t.Fatalf("@%s: expect id to be %s, but got %s", tc.Handle, tc.ExpectId, d.Id())
+		}
+
+		if fmt.Sprint(enabled) != fmt.Sprint(tc.ExpectEnabled) || fmt.Sprint(disabled) != fmt.Sprint(tc.ExpectDisable) {
+			t.Fatalf("@%s: expect %v to be enabled and %v to be disabled, but got %v and %v", tc.Handle, tc.ExpectEnabled, tc.ExpectDisable, enabled, disabled)
+		}
+	}
+}
+
+func Test_ResourceUserGroupUpdateWhileDisabled(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSlackUserGroup().Schema, map[string]interface{}{
+		"handle":      "legacy",
+		"description": "edited",
+		"enabled":     false,
+	})
+	d.SetId("S0003")
+
+	var calls []string
+
+	ctx, team := createTestTeam(t, Routes{
+		{
+			Path: "/usergroups.enable",
+			Response: func(r *http.Request) interface{} {
+				calls = append(calls, r.URL.Path)
+				return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: "S0003"}}
+			},
+		},
+		{
+			Path: "/usergroups.update",
+			Response: func(r *http.Request) interface{} {
+				calls = append(calls, r.URL.Path)
+				return userGroupResponse{
+					slack.SlackResponse{Ok: true},
+					slack.UserGroup{ID: "S0003", Handle: "legacy", Name: "legacy", Description: "edited"},
+				}
+			},
+		},
+		{
+			Path: "/usergroups.disable",
+			Response: func(r *http.Request) interface{} {
+				calls = append(calls, r.URL.Path)
+				return userGroupResponse{slack.SlackResponse{Ok: true}, slack.UserGroup{ID: "S0003"}}
+			},
+		},
+	})
+
+	if diags := resourceSlackUserGroupUpdate(ctx, d, team); diags.HasError() {
+		t.Fatalf("err: %s", diags[0].Summary)
+	}
+
+	// Disabled usergroups reject updates so it's enabled only while updating
+	if expected := []string{"/usergroups.enable", "/usergroups.update", "/usergroups.disable"}; fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Fatalf("expect %v to be called, but got %v", expected, calls)
+	}
+
+	if d.Get("enabled").(bool) {
+		t.Fatalf("expect the usergroup to stay disabled")
+	}
+}
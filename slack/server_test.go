@@ -12,7 +12,8 @@ import (
 type Routes = []Route
 
 type Route struct {
-	Path     string
+	Path string
+	// A func(r *http.Request) interface{} is called on every request to build a stateful response
 	Response interface{}
 }
 
@@ -20,7 +21,13 @@ func createTestTeam(t *testing.T, routes Routes) (context.Context, *Team) {
 	m := http.NewServeMux()
 
 	for _, route := range routes {
+		route := route
 		m.HandleFunc(route.Path, func(w http.ResponseWriter, r *http.Request) {
+			if f, ok := route.Response.(func(r *http.Request) interface{}); ok {
+				renderJson(w, f(r))
+				return
+			}
+
 			renderJson(w, route.Response)
 		})
 	}
@@ -33,13 +40,19 @@ func createTestTeam(t *testing.T, routes Routes) (context.Context, *Team) {
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
+	// Every team has its own cache so that responses of other tests never leak
+	defaultCacheDir := cacheDir
+	cacheDir = t.TempDir()
+
 	t.Cleanup(func() {
 		cancelFunc()
 		ts.Close()
+		cacheDir = defaultCacheDir
 	})
 
 	return ctx, &Team{
 		client: client,
+		api:    newApiClient("test_token", ts.URL+"/", ts.Client()),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 )
 
 func validateEnums(values []string) schema.SchemaValidateDiagFunc {
@@ -35,3 +36,77 @@ func containsAny(values []string, any string) bool {
 
 	return valid
 }
+
+func schemaSetToStrings(set *schema.Set) []string {
+	values := make([]string, set.Len())
+
+	for i, v := range set.List() {
+		values[i] = v.(string)
+	}
+
+	return values
+}
+
+// subtractStrings returns values that are in a but not in b
+func subtractStrings(a []string, b []string) []string {
+	var values []string
+
+	for _, value := range a {
+		if !containsAny(b, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// intersectStrings returns values that are in both a and b
+func intersectStrings(a []string, b []string) []string {
+	var values []string
+
+	for _, value := range a {
+		if containsAny(b, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+
+	for size < len(values) {
+		values, chunks = values[size:], append(chunks, values[0:size:size])
+	}
+
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+
+	return chunks
+}
+
+func buildCompositeId(parentId string, childId string) string {
+	return fmt.Sprintf("%s:%s", parentId, childId)
+}
+
+func splitCompositeId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("%s is not a valid id. Please use <parent id>:<child id> format", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func mapValues(m map[string]string) []string {
+	values := make([]string, 0, len(m))
+
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
@@ -73,3 +73,93 @@ func Test_containsAny(t *testing.T) {
 		}
 	}
 }
+
+func Test_subtractStrings(t *testing.T) {
+	actual := subtractStrings([]string{"foo", "bar", "baz"}, []string{"bar", "none"})
+
+	if len(actual) != 2 || actual[0] != "foo" || actual[1] != "baz" {
+		t.Fatalf("Expected [foo baz] but %v", actual)
+	}
+}
+
+func Test_intersectStrings(t *testing.T) {
+	actual := intersectStrings([]string{"foo", "bar", "baz"}, []string{"bar", "none"})
+
+	if len(actual) != 1 || actual[0] != "bar" {
+		t.Fatalf("Expected [bar] but %v", actual)
+	}
+}
+
+func Test_chunkStrings(t *testing.T) {
+	cases := []struct {
+		Values       []string
+		Size         int
+		ExpectChunks []int
+	}{
+		{
+			Values:       []string{},
+			Size:         2,
+			ExpectChunks: []int{},
+		},
+		{
+			Values:       []string{"a", "b"},
+			Size:         2,
+			ExpectChunks: []int{2},
+		},
+		{
+			Values:       []string{"a", "b", "c", "d", "e"},
+			Size:         2,
+			ExpectChunks: []int{2, 2, 1},
+		},
+	}
+
+	for _, tc := range cases {
+		chunks := chunkStrings(tc.Values, tc.Size)
+
+		if len(chunks) != len(tc.ExpectChunks) {
+			t.Fatalf("Expected %d chunks but %d", len(tc.ExpectChunks), len(chunks))
+		}
+
+		for i, chunk := range chunks {
+			if len(chunk) != tc.ExpectChunks[i] {
+				t.Fatalf("Expected %d values in chunk %d but %d", tc.ExpectChunks[i], i, len(chunk))
+			}
+		}
+	}
+}
+
+func Test_splitCompositeId(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ExpectError bool
+	}{
+		{
+			Value:       buildCompositeId("C123", "U456"),
+			ExpectError: false,
+		},
+		{
+			Value:       "C123",
+			ExpectError: true,
+		},
+		{
+			Value:       "C123:",
+			ExpectError: true,
+		},
+		{
+			Value:       "C123:U456:U789",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		parentId, childId, err := splitCompositeId(tc.Value)
+
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Expected error %t but %v for %s", tc.ExpectError, err, tc.Value)
+		}
+
+		if err == nil && (parentId != "C123" || childId != "U456") {
+			t.Fatalf("Expected C123 and U456 but %s and %s", parentId, childId)
+		}
+	}
+}